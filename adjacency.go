@@ -0,0 +1,228 @@
+package graph
+
+import "sort"
+
+// adjacency is a dense, read only snapshot of a graph used by the algorithms.
+// Nodes are numbered in the sorted order of their ids so every algorithm built
+// on top of it visits them deterministically.
+type adjacency struct {
+
+	// graph this snapshot was taken from.
+	graph *Graph
+
+	// directed is set when the arcs follow the edge direction.
+	directed bool
+
+	// ids of the nodes, in index order.
+	ids []string
+
+	// index of every node id.
+	index map[string]int
+
+	// out holds the arcs leaving every node.
+	out [][]arc
+
+	// in holds the arcs entering every node. For undirected graphs this is the
+	// same as out.
+	in [][]arc
+
+	// nbrs holds the distinct neighbours of every node, excluding itself.
+	nbrs [][]int
+
+	// searches counts the breadth first searches run over the snapshot.
+	searches int
+}
+
+// arc is a single traversable direction of an edge.
+type arc struct {
+
+	// to is the index of the node the arc leads to.
+	to int
+
+	// edge the arc belongs to.
+	edge *Edge
+
+	// weight of the arc.
+	weight float64
+}
+
+// newAdjacency will take a snapshot of the graph, reading the arc weights from
+// the weight attribute. An empty attribute name gives every arc a weight of 1.
+// Hypergraphs are taken as undirected graphs, every hyperedge linking all the
+// pairs of its nodes, so hyperedges of fewer than two nodes link none. Directed
+// hypergraphs are taken as directed graphs, every hyperedge linking all its
+// tail nodes to all its head nodes. A loop gives a single arc from its node to
+// itself, in both directed and undirected graphs, which matrixWeight and degree
+// count twice for undirected graphs.
+func newAdjacency(graph *Graph, weightAttr string) (adj *adjacency, err error) {
+
+	adj = &adjacency{
+		graph: graph,
+		index: make(map[string]int),
+	}
+
+	switch graph.Type {
+	case GraphDirected, GraphDirectedHyper:
+		adj.directed = true
+	case GraphUndirected, GraphHyper:
+		adj.directed = false
+	default:
+		return nil, &GraphTypeError{Type: graph.Type, Err: ErrUnknownGraphType}
+	}
+
+	adj.ids = graph.NodeOrder()
+	for i, id := range adj.ids {
+		adj.index[id] = i
+	}
+
+	adj.out = make([][]arc, len(adj.ids))
+	if adj.directed {
+		adj.in = make([][]arc, len(adj.ids))
+	} else {
+		adj.in = adj.out
+	}
+
+	for _, edge := range sortedEdges(graph) {
+		var weight float64
+		if weight, err = edgeWeight(edge, weightAttr); err != nil {
+			return nil, err
+		}
+
+		if adj.directed {
+			tail, head := edge.Ends()
+			for _, from := range tail {
+				source := adj.index[from.ID]
+				for _, node := range head {
+					target := adj.index[node.ID]
+					adj.out[source] = append(adj.out[source], arc{to: target, edge: edge, weight: weight})
+					adj.in[target] = append(adj.in[target], arc{to: source, edge: edge, weight: weight})
+				}
+			}
+		} else {
+			var ends []int
+			for id := range edge.Nodes {
+				ends = append(ends, adj.index[id])
+			}
+			sort.Ints(ends)
+
+			if edge.IsLoop() {
+				adj.out[ends[0]] = append(adj.out[ends[0]], arc{to: ends[0], edge: edge, weight: weight})
+			}
+
+			for i, u := range ends {
+				for _, v := range ends[i+1:] {
+					adj.out[u] = append(adj.out[u], arc{to: v, edge: edge, weight: weight})
+					adj.out[v] = append(adj.out[v], arc{to: u, edge: edge, weight: weight})
+				}
+			}
+		}
+	}
+
+	adj.nbrs = make([][]int, len(adj.ids))
+	for u := range adj.ids {
+		seen := map[int]bool{u: true}
+		for _, a := range adj.out[u] {
+			if !seen[a.to] {
+				seen[a.to] = true
+				adj.nbrs[u] = append(adj.nbrs[u], a.to)
+			}
+		}
+		if adj.directed {
+			for _, a := range adj.in[u] {
+				if !seen[a.to] {
+					seen[a.to] = true
+					adj.nbrs[u] = append(adj.nbrs[u], a.to)
+				}
+			}
+		}
+		sort.Ints(adj.nbrs[u])
+	}
+
+	return
+}
+
+// lookup will find the index of the node id.
+func (adj *adjacency) lookup(id string) (u int, err error) {
+	var ok bool
+	if u, ok = adj.index[id]; !ok {
+		err = &NodeError{ID: id, Err: ErrNodeNotFound}
+	}
+	return
+}
+
+// size is the number of nodes in the snapshot.
+func (adj *adjacency) size() int {
+	return len(adj.ids)
+}
+
+// matrixWeight is the weight the arc adds to the adjacency matrix, twice its
+// weight for the single arc of an undirected loop.
+func (adj *adjacency) matrixWeight(u int, a arc) float64 {
+	if !adj.directed && a.to == u {
+		return 2 * a.weight
+	}
+	return a.weight
+}
+
+// degree counts the arcs leaving the node, the single arc of an undirected loop
+// twice, matching Node.Degree and the adjacency matrix.
+func (adj *adjacency) degree(u int) (degree int) {
+	for _, a := range adj.out[u] {
+		degree++
+		if !adj.directed && a.to == u {
+			degree++
+		}
+	}
+	return
+}
+
+// sortedEdges will return the edges of the graph in the order of their ids.
+func sortedEdges(graph *Graph) (edges []*Edge) {
+	for _, edge := range graph.Edges {
+		edges = append(edges, edge)
+	}
+
+	sort.Slice(edges, func(i, j int) bool {
+		return edges[i].ID < edges[j].ID
+	})
+
+	return
+}
+
+// edgeWeight will read the numeric weight of the edge. Edges without the
+// attribute, or an empty attribute name, weigh 1.
+func edgeWeight(edge *Edge, weightAttr string) (weight float64, err error) {
+	weight = 1
+
+	if len(weightAttr) != 0 {
+		if value, ok := edge.Attributes.Get(weightAttr); ok {
+			switch v := value.(type) {
+			case float64:
+				weight = v
+			case float32:
+				weight = float64(v)
+			case int:
+				weight = float64(v)
+			case int64:
+				weight = float64(v)
+			default:
+				err = &EdgeError{ID: edge.ID, Err: ErrNonNumericWeight}
+			}
+		}
+	}
+
+	return
+}
+
+// checkWeights will reject the arcs of negative weight, which the shortest path
+// searches cannot handle.
+func (adj *adjacency) checkWeights() (err error) {
+	for _, arcs := range adj.out {
+		for _, a := range arcs {
+			if a.weight < 0 {
+				return &EdgeError{ID: a.edge.ID, Err: ErrNegativeWeight}
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,266 @@
+package graph
+
+import (
+	"math"
+	"sort"
+)
+
+// DendrogramLevel is one split of the Girvan-Newman dendrogram.
+type DendrogramLevel struct {
+
+	// Removed holds the ids of the edges removed since the previous level, in
+	// the order they were removed.
+	Removed []string
+
+	// Components of the graph at this level. Every component is sorted by node
+	// id and the components are sorted by their first node id.
+	Components [][]string
+}
+
+// predecessor is a node preceding another on a shortest path, through an edge.
+type predecessor struct {
+	node int
+	edge *Edge
+}
+
+// EdgeBetweenness computes the betweenness centrality of every edge, keyed by
+// edge id. Edges are weighted by the weight attribute, where an empty name
+// counts hops, and weights must not be negative. When normalized the scores are divided by the number of node
+// pairs: n(n-1) ordered pairs for directed graphs, and n(n-1)/2 unordered pairs
+// for undirected graphs.
+func (graph *Graph) EdgeBetweenness(weightAttr string, normalized bool) (betweenness map[string]float64, err error) {
+	var adj *adjacency
+	if adj, err = newWeightedAdjacency(graph, weightAttr); err == nil {
+		scores := adj.edgeBetweenness(len(weightAttr) != 0, nil)
+		adj.rescale(scores, normalized)
+
+		betweenness = make(map[string]float64, len(graph.Edges))
+		for id, edge := range graph.Edges {
+			betweenness[id] = scores[edge]
+		}
+	}
+
+	return
+}
+
+// GirvanNewman builds the divisive clustering dendrogram of the graph. The edge
+// with the highest betweenness is removed, ties going to the smallest edge id,
+// and the betweenness recomputed until no edges remain. A level is recorded
+// every time the number of components grows. The first level holds the
+// components of the untouched graph and the last one the isolated nodes.
+func (graph *Graph) GirvanNewman(weightAttr string) (levels []DendrogramLevel, err error) {
+	var adj *adjacency
+	if adj, err = newWeightedAdjacency(graph, weightAttr); err != nil {
+		return
+	}
+
+	removed := map[*Edge]bool{}
+	components := adj.components(removed)
+	levels = append(levels, DendrogramLevel{Components: adj.componentIds(components)})
+
+	var pending []string
+	for len(removed) < len(graph.Edges) {
+		scores := adj.edgeBetweenness(len(weightAttr) != 0, removed)
+
+		var best *Edge
+		for _, edge := range sortedEdges(graph) {
+			if !removed[edge] && (best == nil || scores[edge] > scores[best]) {
+				best = edge
+			}
+		}
+
+		removed[best] = true
+		pending = append(pending, best.ID)
+
+		if split := adj.components(removed); len(split) > len(components) {
+			components = split
+			levels = append(levels, DendrogramLevel{Removed: pending, Components: adj.componentIds(split)})
+			pending = nil
+		}
+	}
+
+	return
+}
+
+// newWeightedAdjacency will take a snapshot of the graph for the shortest path
+// searches, rejecting negative weights when weighted.
+func newWeightedAdjacency(graph *Graph, weightAttr string) (adj *adjacency, err error) {
+	if adj, err = newAdjacency(graph, weightAttr); err == nil && len(weightAttr) != 0 {
+		if err = adj.checkWeights(); err != nil {
+			adj = nil
+		}
+	}
+	return
+}
+
+// edgeBetweenness will accumulate the raw betweenness of every edge, ignoring
+// the removed edges.
+func (adj *adjacency) edgeBetweenness(weighted bool, removed map[*Edge]bool) (scores map[*Edge]float64) {
+	scores = map[*Edge]float64{}
+
+	n := adj.size()
+	for s := 0; s < n; s++ {
+		sigma := make([]float64, n)
+		pred := make([][]predecessor, n)
+
+		var order []int
+		if weighted {
+			order = adj.dijkstraPaths(s, removed, sigma, pred)
+		} else {
+			order = adj.bfsPaths(s, removed, sigma, pred)
+		}
+
+		delta := make([]float64, n)
+		for i := len(order) - 1; i >= 0; i-- {
+			w := order[i]
+			for _, p := range pred[w] {
+				c := sigma[p.node] / sigma[w] * (1 + delta[w])
+				scores[p.edge] += c
+				delta[p.node] += c
+			}
+		}
+	}
+
+	return
+}
+
+// bfsPaths will count the shortest paths from the source by hops, returning the
+// nodes in the order they were reached.
+func (adj *adjacency) bfsPaths(s int, removed map[*Edge]bool, sigma []float64, pred [][]predecessor) (order []int) {
+	dist := make([]int, adj.size())
+	for i := range dist {
+		dist[i] = -1
+	}
+
+	dist[s] = 0
+	sigma[s] = 1
+
+	for Q := []int{s}; len(Q) != 0; {
+		v := Q[0]
+		Q = Q[1:]
+		order = append(order, v)
+
+		for _, arc := range adj.out[v] {
+			if removed[arc.edge] {
+				continue
+			}
+
+			w := arc.to
+			if dist[w] < 0 {
+				dist[w] = dist[v] + 1
+				Q = append(Q, w)
+			}
+
+			if dist[w] == dist[v]+1 {
+				sigma[w] += sigma[v]
+				pred[w] = append(pred[w], predecessor{node: v, edge: arc.edge})
+			}
+		}
+	}
+
+	return
+}
+
+// dijkstraPaths will count the shortest weighted paths from the source,
+// returning the nodes in the order they were settled.
+func (adj *adjacency) dijkstraPaths(s int, removed map[*Edge]bool, sigma []float64, pred [][]predecessor) (order []int) {
+	dist := make([]float64, adj.size())
+	for i := range dist {
+		dist[i] = math.Inf(1)
+	}
+	done := make([]bool, adj.size())
+
+	dist[s] = 0
+	sigma[s] = 1
+
+	pq := &priorityQueue{}
+	for pq.push(s, 0); pq.Len() != 0; {
+		v, _ := pq.pop()
+		if done[v] {
+			continue
+		}
+		done[v] = true
+		order = append(order, v)
+
+		for _, arc := range adj.out[v] {
+			w := arc.to
+			if removed[arc.edge] || done[w] {
+				continue
+			}
+
+			if alt := dist[v] + arc.weight; alt < dist[w] {
+				dist[w] = alt
+				sigma[w] = sigma[v]
+				pred[w] = []predecessor{{node: v, edge: arc.edge}}
+				pq.push(w, alt)
+			} else if alt == dist[w] {
+				sigma[w] += sigma[v]
+				pred[w] = append(pred[w], predecessor{node: v, edge: arc.edge})
+			}
+		}
+	}
+
+	return
+}
+
+// rescale will scale the raw betweenness scores. Undirected graphs count every
+// path in both directions, so their raw scores are halved.
+func (adj *adjacency) rescale(scores map[*Edge]float64, normalized bool) {
+	scale := 1.0
+	if n := float64(adj.size()); normalized && n > 1 {
+		scale = 1 / (n * (n - 1))
+		if !adj.directed {
+			scale *= 2
+		}
+	}
+
+	if !adj.directed {
+		scale /= 2
+	}
+
+	for edge := range scores {
+		scores[edge] *= scale
+	}
+}
+
+// components will find the weakly connected components, ignoring the removed edges.
+func (adj *adjacency) components(removed map[*Edge]bool) (components [][]int) {
+	seen := make([]bool, adj.size())
+
+	for s := range adj.ids {
+		if seen[s] {
+			continue
+		}
+
+		seen[s] = true
+		component := []int{s}
+		for i := 0; i < len(component); i++ {
+			v := component[i]
+			for _, arcs := range [][]arc{adj.out[v], adj.in[v]} {
+				for _, arc := range arcs {
+					if !removed[arc.edge] && !seen[arc.to] {
+						seen[arc.to] = true
+						component = append(component, arc.to)
+					}
+				}
+			}
+		}
+
+		sort.Ints(component)
+		components = append(components, component)
+	}
+
+	return
+}
+
+// componentIds will convert the components to node ids.
+func (adj *adjacency) componentIds(components [][]int) (ids [][]string) {
+	for _, component := range components {
+		var members []string
+		for _, u := range component {
+			members = append(members, adj.ids[u])
+		}
+		ids = append(ids, members)
+	}
+	return
+}
@@ -1,13 +1,6 @@
 package graph
 
-import (
-	"math/rand"
-	"time"
-)
-
-func init() {
-	rand.Seed(time.Now().UnixNano())
-}
+import "math/rand"
 
 var letterRunes = []rune("abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789")
 
@@ -25,7 +18,8 @@ func (graph *Graph) BFSd(root string) (err error) {
 	return graph.BFS(root, "d")
 }
 
-// BFS is the breadth-first-search algorithm on the graph.
+// BFS is the breadth-first-search algorithm on the graph. Loops lead back to a
+// node already reached, so they never change a depth.
 func (graph *Graph) BFS(root string, valueAttr string) (err error) {
 	colorAttr := randStringRunes(48)
 	predecessorAttr := randStringRunes(48)
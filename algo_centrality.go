@@ -0,0 +1,237 @@
+package graph
+
+import "math"
+
+const (
+
+	// defaultMaxIterations is the iteration limit used by the power iteration solvers.
+	defaultMaxIterations = 100
+
+	// defaultTolerance is the per node tolerance used by the power iteration solvers.
+	defaultTolerance = 1.0e-6
+)
+
+// IterationOptions tunes the power iteration solvers. A nil value will use the
+// defaults.
+type IterationOptions struct {
+
+	// WeightAttr is the edge attribute holding the weight. Empty means unweighted.
+	WeightAttr string
+
+	// MaxIterations before the solver gives up. Zero uses the default of 100.
+	MaxIterations int
+
+	// Tolerance per node used to detect convergence. Zero uses the default of 1e-6.
+	Tolerance float64
+}
+
+// Convergence reports how a power iteration solver finished.
+type Convergence struct {
+
+	// Iterations performed.
+	Iterations int
+
+	// Delta is the L1 change of the scores during the last iteration.
+	Delta float64
+
+	// Converged is set when Delta dropped below the tolerance.
+	Converged bool
+}
+
+// settings will resolve the options against the defaults.
+func (options *IterationOptions) settings() (weightAttr string, maxIterations int, tolerance float64) {
+	maxIterations = defaultMaxIterations
+	tolerance = defaultTolerance
+
+	if options != nil {
+		weightAttr = options.WeightAttr
+		if options.MaxIterations > 0 {
+			maxIterations = options.MaxIterations
+		}
+		if options.Tolerance > 0 {
+			tolerance = options.Tolerance
+		}
+	}
+
+	return
+}
+
+// HITS computes the hub and authority scores of every node. Both score sets are
+// normalized to sum to 1. For undirected graphs hubs and authorities are equal.
+func (graph *Graph) HITS(options *IterationOptions) (hubs map[string]float64, authorities map[string]float64, report Convergence, err error) {
+	weightAttr, maxIterations, tolerance := options.settings()
+
+	var adj *adjacency
+	if adj, err = newAdjacency(graph, weightAttr); err != nil {
+		return
+	}
+
+	n := adj.size()
+	report.Converged = n == 0
+	h := make([]float64, n)
+	a := make([]float64, n)
+	for i := range h {
+		h[i] = 1.0 / float64(n)
+	}
+
+	for report.Iterations < maxIterations && !report.Converged {
+		report.Iterations++
+		last := h
+
+		// authorities collect from the hubs pointing at them.
+		a = make([]float64, n)
+		for u := range last {
+			for _, arc := range adj.out[u] {
+				a[arc.to] += last[u] * adj.matrixWeight(u, arc)
+			}
+		}
+		normalizeSum(a)
+
+		// hubs collect from the authorities they point at.
+		h = make([]float64, n)
+		for u := range a {
+			for _, arc := range adj.out[u] {
+				h[u] += a[arc.to] * adj.matrixWeight(u, arc)
+			}
+		}
+		normalizeSum(h)
+
+		report.Delta = l1Distance(h, last)
+		report.Converged = report.Delta < float64(n)*tolerance
+	}
+
+	hubs = adj.scores(h)
+	authorities = adj.scores(a)
+	return
+}
+
+// EigenvectorCentrality computes the eigenvector centrality of every node. For
+// directed graphs the score of a node is taken from its in-edges. The result is
+// normalized to unit length.
+func (graph *Graph) EigenvectorCentrality(options *IterationOptions) (centrality map[string]float64, report Convergence, err error) {
+	weightAttr, maxIterations, tolerance := options.settings()
+
+	var adj *adjacency
+	if adj, err = newAdjacency(graph, weightAttr); err != nil {
+		return
+	}
+
+	n := adj.size()
+	report.Converged = n == 0
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = 1.0 / float64(n)
+	}
+
+	for report.Iterations < maxIterations && !report.Converged {
+		report.Iterations++
+		last := x
+
+		// Iterate on (A + I) so bipartite graphs do not oscillate.
+		x = make([]float64, n)
+		copy(x, last)
+		for u := range last {
+			for _, arc := range adj.out[u] {
+				x[arc.to] += last[u] * adj.matrixWeight(u, arc)
+			}
+		}
+
+		if normalizeL2(x) == 0 {
+			err = ErrNoEdges
+			return
+		}
+
+		report.Delta = l1Distance(x, last)
+		report.Converged = report.Delta < float64(n)*tolerance
+	}
+
+	centrality = adj.scores(x)
+	return
+}
+
+// KatzCentrality computes the Katz centrality of every node, where alpha is the
+// attenuation factor and beta the base score of each node. Alpha must be below
+// the reciprocal of the largest eigenvalue of the adjacency matrix for the
+// solver to converge. The result is normalized to unit length.
+func (graph *Graph) KatzCentrality(alpha float64, beta float64, options *IterationOptions) (centrality map[string]float64, report Convergence, err error) {
+	weightAttr, maxIterations, tolerance := options.settings()
+
+	var adj *adjacency
+	if adj, err = newAdjacency(graph, weightAttr); err != nil {
+		return
+	}
+
+	n := adj.size()
+	report.Converged = n == 0
+	x := make([]float64, n)
+
+	for report.Iterations < maxIterations && !report.Converged {
+		report.Iterations++
+		last := x
+
+		x = make([]float64, n)
+		for u := range last {
+			for _, arc := range adj.out[u] {
+				x[arc.to] += last[u] * adj.matrixWeight(u, arc)
+			}
+		}
+		for i := range x {
+			x[i] = alpha*x[i] + beta
+		}
+
+		report.Delta = l1Distance(x, last)
+		report.Converged = report.Delta < float64(n)*tolerance
+	}
+
+	normalizeL2(x)
+	centrality = adj.scores(x)
+	return
+}
+
+// scores will key the values by node id.
+func (adj *adjacency) scores(values []float64) (scores map[string]float64) {
+	scores = make(map[string]float64, len(values))
+	for i, value := range values {
+		scores[adj.ids[i]] = value
+	}
+	return
+}
+
+// normalizeSum will scale the values to sum to 1, returning the original sum.
+func normalizeSum(values []float64) (sum float64) {
+	for _, value := range values {
+		sum += value
+	}
+
+	if sum != 0 {
+		for i := range values {
+			values[i] /= sum
+		}
+	}
+
+	return
+}
+
+// normalizeL2 will scale the values to unit length, returning the original length.
+func normalizeL2(values []float64) (norm float64) {
+	for _, value := range values {
+		norm += value * value
+	}
+	norm = math.Sqrt(norm)
+
+	if norm != 0 {
+		for i := range values {
+			values[i] /= norm
+		}
+	}
+
+	return
+}
+
+// l1Distance is the sum of the absolute differences of the values.
+func l1Distance(a []float64, b []float64) (distance float64) {
+	for i := range a {
+		distance += math.Abs(a[i] - b[i])
+	}
+	return
+}
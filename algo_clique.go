@@ -0,0 +1,155 @@
+package graph
+
+import "sort"
+
+// MaximalCliques enumerates the maximal cliques of an undirected graph with the
+// Bron-Kerbosch algorithm, pivoting on the node covering the most candidates and
+// seeding the search in degeneracy order. Loops are ignored. Every clique is
+// passed to the iteration function as its sorted node ids; the enumeration
+// stops when the function returns false or an error.
+func (graph *Graph) MaximalCliques(iterFunc func(clique []string) (cont bool, err error)) (err error) {
+	if iterFunc == nil {
+		return ErrNoIterationFunc
+	}
+
+	var adj *adjacency
+	if adj, err = newUndirectedAdjacency(graph, ""); err == nil {
+		adj.cliques(func(clique []int) (cont bool) {
+			cont, err = iterFunc(adj.sortedIds(clique))
+			return cont && err == nil
+		}, nil)
+	}
+
+	return
+}
+
+// MaximumClique finds a largest clique of an undirected graph as its sorted node
+// ids. Among cliques of the same size the first one found wins.
+func (graph *Graph) MaximumClique() (clique []string, err error) {
+	var adj *adjacency
+	if adj, err = newUndirectedAdjacency(graph, ""); err == nil {
+		var best []int
+		adj.cliques(func(found []int) bool {
+			if len(found) > len(best) {
+				best = append([]int{}, found...)
+			}
+			return true
+		}, func(size int) bool {
+			return size <= len(best)
+		})
+		clique = adj.sortedIds(best)
+	}
+	return
+}
+
+// cliques will run the Bron-Kerbosch search, passing every maximal clique to
+// visit until it returns false. The optional prune function skips the branches
+// that cannot grow past the given size.
+func (adj *adjacency) cliques(visit func(clique []int) bool, prune func(size int) bool) {
+	n := adj.size()
+
+	nbrs := make([]map[int]bool, n)
+	for u, list := range adj.nbrs {
+		nbrs[u] = make(map[int]bool, len(list))
+		for _, v := range list {
+			nbrs[u][v] = true
+		}
+	}
+
+	var expand func(r []int, p []int, x []int) bool
+	expand = func(r []int, p []int, x []int) bool {
+		if len(p) == 0 {
+			if len(x) == 0 {
+				return visit(r)
+			}
+			return true
+		}
+
+		if prune != nil && prune(len(r)+len(p)) {
+			return true
+		}
+
+		// pick the pivot covering the most candidates.
+		pivot, covered := -1, -1
+		for _, set := range [][]int{p, x} {
+			for _, u := range set {
+				count := 0
+				for _, v := range p {
+					if nbrs[u][v] {
+						count++
+					}
+				}
+				if count > covered {
+					pivot, covered = u, count
+				}
+			}
+		}
+
+		var candidates []int
+		for _, v := range p {
+			if !nbrs[pivot][v] {
+				candidates = append(candidates, v)
+			}
+		}
+
+		for _, v := range candidates {
+			var np, nx []int
+			for _, u := range p {
+				if nbrs[v][u] {
+					np = append(np, u)
+				}
+			}
+			for _, u := range x {
+				if nbrs[v][u] {
+					nx = append(nx, u)
+				}
+			}
+
+			if !expand(append(r[:len(r):len(r)], v), np, nx) {
+				return false
+			}
+
+			// move v from the candidates to the excluded nodes.
+			for i, u := range p {
+				if u == v {
+					p = append(p[:i:i], p[i+1:]...)
+					break
+				}
+			}
+			x = append(x[:len(x):len(x)], v)
+		}
+
+		return true
+	}
+
+	_, order := adj.coreDecomposition()
+	position := make([]int, n)
+	for i, u := range order {
+		position[u] = i
+	}
+
+	for _, v := range order {
+		var p, x []int
+		for _, u := range adj.nbrs[v] {
+			if position[u] > position[v] {
+				p = append(p, u)
+			} else {
+				x = append(x, u)
+			}
+		}
+
+		if !expand([]int{v}, p, x) {
+			break
+		}
+	}
+}
+
+// sortedIds will convert the node indexes to sorted node ids.
+func (adj *adjacency) sortedIds(nodes []int) (ids []string) {
+	ids = []string{}
+	for _, u := range nodes {
+		ids = append(ids, adj.ids[u])
+	}
+	sort.Strings(ids)
+	return
+}
@@ -0,0 +1,423 @@
+package graph
+
+import (
+	"math/rand"
+	"sort"
+	"strconv"
+)
+
+// weightedGraph is the compact, undirected working graph used while detecting
+// communities. Loops carry twice the weight of the edges they replace so the
+// degree of every node stays the sum of its row.
+type weightedGraph struct {
+
+	// links holds the weight to every neighbour, including the node itself.
+	links []map[int]float64
+
+	// degree is the weighted degree of every node.
+	degree []float64
+
+	// total is the sum of all the degrees.
+	total float64
+}
+
+// newWeightedGraph will collapse the snapshot into a weighted graph, summing
+// the weight of parallel edges. The single arc of a loop is counted twice.
+func newWeightedGraph(adj *adjacency) (wg *weightedGraph) {
+	wg = &weightedGraph{
+		links:  make([]map[int]float64, adj.size()),
+		degree: make([]float64, adj.size()),
+	}
+
+	for u := range adj.out {
+		wg.links[u] = make(map[int]float64)
+		for _, arc := range adj.out[u] {
+			weight := adj.matrixWeight(u, arc)
+			wg.links[u][arc.to] += weight
+			wg.degree[u] += weight
+		}
+		wg.total += wg.degree[u]
+	}
+
+	return
+}
+
+// aggregate will build the weighted graph of the communities.
+func (wg *weightedGraph) aggregate(community []int, count int) (result *weightedGraph) {
+	result = &weightedGraph{
+		links:  make([]map[int]float64, count),
+		degree: make([]float64, count),
+		total:  wg.total,
+	}
+
+	for c := range result.links {
+		result.links[c] = make(map[int]float64)
+	}
+
+	for u, links := range wg.links {
+		cu := community[u]
+		for v, weight := range links {
+			result.links[cu][community[v]] += weight
+		}
+		result.degree[cu] += wg.degree[u]
+	}
+
+	return
+}
+
+// modularity of the partition of the weighted graph.
+func (wg *weightedGraph) modularity(community []int) (q float64) {
+	if wg.total == 0 {
+		return
+	}
+
+	internal := map[int]float64{}
+	totals := map[int]float64{}
+	for u, links := range wg.links {
+		for v, weight := range links {
+			if community[u] == community[v] {
+				internal[community[u]] += weight
+			}
+		}
+		totals[community[u]] += wg.degree[u]
+	}
+
+	for c, tot := range totals {
+		q += internal[c]/wg.total - (tot/wg.total)*(tot/wg.total)
+	}
+
+	return
+}
+
+// sortedKeys will return the keys of the weights in ascending order.
+func sortedKeys(weights map[int]float64) (keys []int) {
+	for key := range weights {
+		keys = append(keys, key)
+	}
+	sort.Ints(keys)
+	return
+}
+
+// moveNodes is the local moving phase of Louvain. It returns true when at
+// least one node changed community.
+func (wg *weightedGraph) moveNodes(community []int, random *rand.Rand) (moved bool) {
+	n := len(wg.links)
+
+	totals := make([]float64, n)
+	for u := range wg.links {
+		totals[community[u]] += wg.degree[u]
+	}
+
+	for improved := true; improved; {
+		improved = false
+
+		for _, u := range random.Perm(n) {
+			current := community[u]
+
+			// weight from u to every neighbouring community.
+			toward := map[int]float64{}
+			for v, weight := range wg.links[u] {
+				if v != u {
+					toward[community[v]] += weight
+				}
+			}
+
+			totals[current] -= wg.degree[u]
+
+			best := current
+			bestGain := toward[current] - totals[current]*wg.degree[u]/wg.total
+			for _, c := range sortedKeys(toward) {
+				if gain := toward[c] - totals[c]*wg.degree[u]/wg.total; gain > bestGain {
+					best = c
+					bestGain = gain
+				}
+			}
+
+			totals[best] += wg.degree[u]
+			if best != current {
+				community[u] = best
+				improved = true
+				moved = true
+			}
+		}
+	}
+
+	return
+}
+
+// renumber will number the communities from 0 in order of their first member,
+// returning the number of communities.
+func renumber(community []int) (count int) {
+	numbers := map[int]int{}
+	for u, c := range community {
+		if _, ok := numbers[c]; !ok {
+			numbers[c] = count
+			count++
+		}
+		community[u] = numbers[c]
+	}
+	return
+}
+
+// Louvain detects communities by greedy modularity optimization. The source
+// drives the order the nodes are visited in; a nil source uses a fixed seed.
+// The communities are numbered from 0 in the order of their smallest node id.
+func (graph *Graph) Louvain(weightAttr string, source rand.Source) (communities map[string]int, modularity float64, err error) {
+	var adj *adjacency
+	if adj, err = newAdjacency(graph, weightAttr); err != nil {
+		return
+	} else if adj.directed {
+		err = &GraphTypeError{Type: graph.Type, Err: ErrNotUndirected}
+		return
+	}
+
+	if source == nil {
+		source = rand.NewSource(1)
+	}
+	random := rand.New(source)
+
+	wg := newWeightedGraph(adj)
+
+	// membership maps every original node to its community in the current level.
+	membership := make([]int, adj.size())
+	for u := range membership {
+		membership[u] = u
+	}
+
+	for wg.total > 0 {
+		community := make([]int, len(wg.links))
+		for u := range community {
+			community[u] = u
+		}
+
+		if !wg.moveNodes(community, random) {
+			break
+		}
+
+		count := renumber(community)
+		for u := range membership {
+			membership[u] = community[membership[u]]
+		}
+
+		wg = wg.aggregate(community, count)
+	}
+
+	renumber(membership)
+
+	communities = adj.communities(membership)
+	modularity = newWeightedGraph(adj).modularity(membership)
+	return
+}
+
+// LabelPropagation detects communities with asynchronous label propagation.
+// Every node repeatedly adopts the label carrying the most weight among its
+// neighbours until no node wants to change. The source drives the visiting
+// order and breaks ties; a nil source uses a fixed seed.
+func (graph *Graph) LabelPropagation(weightAttr string, source rand.Source) (communities map[string]int, modularity float64, err error) {
+	var adj *adjacency
+	if adj, err = newAdjacency(graph, weightAttr); err != nil {
+		return
+	} else if adj.directed {
+		err = &GraphTypeError{Type: graph.Type, Err: ErrNotUndirected}
+		return
+	}
+
+	if source == nil {
+		source = rand.NewSource(1)
+	}
+	random := rand.New(source)
+
+	wg := newWeightedGraph(adj)
+
+	label := make([]int, adj.size())
+	for u := range label {
+		label[u] = u
+	}
+
+	// bestLabels will find the labels carrying the most weight around u.
+	bestLabels := func(u int) (best []int) {
+		weights := map[int]float64{}
+		for v, weight := range wg.links[u] {
+			if v != u {
+				weights[label[v]] += weight
+			}
+		}
+
+		max := 0.0
+		for _, l := range sortedKeys(weights) {
+			if weight := weights[l]; len(best) == 0 || weight > max {
+				best = []int{l}
+				max = weight
+			} else if weight == max {
+				best = append(best, l)
+			}
+		}
+		return
+	}
+
+	for changed := true; changed; {
+		changed = false
+
+		for _, u := range random.Perm(len(label)) {
+			if best := bestLabels(u); len(best) != 0 {
+				keep := false
+				for _, l := range best {
+					keep = keep || l == label[u]
+				}
+
+				if !keep {
+					label[u] = best[random.Intn(len(best))]
+					changed = true
+				}
+			}
+		}
+	}
+
+	renumber(label)
+
+	communities = adj.communities(label)
+	modularity = wg.modularity(label)
+	return
+}
+
+// Modularity computes the modularity of the partition of an undirected graph.
+func (graph *Graph) Modularity(communities map[string]int, weightAttr string) (modularity float64, err error) {
+	var adj *adjacency
+	if adj, err = newAdjacency(graph, weightAttr); err != nil {
+		return
+	} else if adj.directed {
+		err = &GraphTypeError{Type: graph.Type, Err: ErrNotUndirected}
+		return
+	}
+
+	var membership []int
+	if membership, err = adj.membership(communities); err == nil {
+		modularity = newWeightedGraph(adj).modularity(membership)
+	}
+
+	return
+}
+
+// SetCommunityAttribute will store the community of every node in the attribute.
+func (graph *Graph) SetCommunityAttribute(attr string, communities map[string]int) (err error) {
+	for id, node := range graph.Nodes {
+		if c, ok := communities[id]; ok {
+			node.Attributes.Set(attr, c)
+		} else {
+			err = &NodeError{ID: id, Err: ErrNoCommunity}
+			break
+		}
+	}
+	return
+}
+
+// CommunityGraph builds the quotient graph of the communities. Every community
+// becomes a node, identified by its number, with a 'size' attribute counting its
+// members and a 'weight' attribute holding its internal edge weight. Communities
+// are linked by a single edge whose 'weight' attribute sums the edges between them.
+func (graph *Graph) CommunityGraph(communities map[string]int, weightAttr string) (quotient *Graph, err error) {
+	var adj *adjacency
+	if adj, err = newAdjacency(graph, weightAttr); err != nil {
+		return
+	} else if adj.directed {
+		err = &GraphTypeError{Type: graph.Type, Err: ErrNotUndirected}
+		return
+	}
+
+	var membership []int
+	if membership, err = adj.membership(communities); err != nil {
+		return
+	}
+
+	if quotient, err = NewGraph(GraphUndirected); err != nil {
+		return
+	}
+
+	sizes := map[int]int{}
+	for _, c := range membership {
+		sizes[c]++
+	}
+
+	var numbers []int
+	for c := range sizes {
+		numbers = append(numbers, c)
+	}
+	sort.Ints(numbers)
+
+	for _, c := range numbers {
+		var node *Node
+		if node, err = quotient.AddNode(strconv.Itoa(c)); err != nil {
+			return
+		}
+		node.Attributes.Set("size", sizes[c])
+		node.Attributes.Set("weight", 0.0)
+	}
+
+	between := map[[2]int]float64{}
+	for _, edge := range sortedEdges(graph) {
+		var weight float64
+		if weight, err = edgeWeight(edge, weightAttr); err != nil {
+			return
+		}
+
+		var ends []int
+		for _, node := range edge.Order {
+			ends = append(ends, membership[adj.index[node.ID]])
+		}
+
+		for i, a := range ends {
+			for _, b := range ends[i+1:] {
+				if a == b {
+					node := quotient.Nodes[strconv.Itoa(a)]
+					internal, _ := node.Attributes.Get("weight")
+					node.Attributes.Set("weight", internal.(float64)+weight)
+				} else if a < b {
+					between[[2]int{a, b}] += weight
+				} else {
+					between[[2]int{b, a}] += weight
+				}
+			}
+		}
+	}
+
+	var pairs [][2]int
+	for pair := range between {
+		pairs = append(pairs, pair)
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		return pairs[i][0] < pairs[j][0] || (pairs[i][0] == pairs[j][0] && pairs[i][1] < pairs[j][1])
+	})
+
+	for _, pair := range pairs {
+		attrs := NewAttributeCollection()
+		attrs.Set("weight", between[pair])
+		if _, err = quotient.AddEdge(attrs, []string{strconv.Itoa(pair[0]), strconv.Itoa(pair[1])}); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// communities will key the community numbers by node id.
+func (adj *adjacency) communities(membership []int) (communities map[string]int) {
+	communities = make(map[string]int, len(membership))
+	for u, c := range membership {
+		communities[adj.ids[u]] = c
+	}
+	return
+}
+
+// membership will index the communities by node.
+func (adj *adjacency) membership(communities map[string]int) (membership []int, err error) {
+	membership = make([]int, adj.size())
+	for u, id := range adj.ids {
+		if c, ok := communities[id]; ok {
+			membership[u] = c
+		} else {
+			err = &NodeError{ID: id, Err: ErrNoCommunity}
+			break
+		}
+	}
+	return
+}
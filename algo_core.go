@@ -0,0 +1,112 @@
+package graph
+
+// CoreNumbers computes the core number of every node, keyed by node id, with
+// the linear time algorithm of Batagelj and Zaversnik. The core number is the
+// largest k for which the node belongs to the k-core. Edge direction, parallel
+// edges and loops are ignored.
+func (graph *Graph) CoreNumbers() (cores map[string]int, err error) {
+	var adj *adjacency
+	if adj, err = newAdjacency(graph, ""); err == nil {
+		core, _ := adj.coreDecomposition()
+		cores = make(map[string]int, adj.size())
+		for u, k := range core {
+			cores[adj.ids[u]] = k
+		}
+	}
+	return
+}
+
+// DegeneracyOrdering returns the node ids in the order the core decomposition
+// removes them. Every node has at most degeneracy neighbours later in the order.
+func (graph *Graph) DegeneracyOrdering() (order []string, degeneracy int, err error) {
+	var adj *adjacency
+	if adj, err = newAdjacency(graph, ""); err == nil {
+		core, vert := adj.coreDecomposition()
+		for _, u := range vert {
+			order = append(order, adj.ids[u])
+			if core[u] > degeneracy {
+				degeneracy = core[u]
+			}
+		}
+	}
+	return
+}
+
+// KCore returns the subgraph induced by the nodes with a core number of at
+// least k.
+func (graph *Graph) KCore(k int) (core *Graph, err error) {
+	var cores map[string]int
+	if cores, err = graph.CoreNumbers(); err == nil {
+		var ids []string
+		for _, id := range graph.NodeOrder() {
+			if cores[id] >= k {
+				ids = append(ids, id)
+			}
+		}
+		core, err = graph.Subgraph(ids)
+	}
+	return
+}
+
+// coreDecomposition will compute the core number of every node and the order
+// the nodes were removed in. The nodes are kept in an array sorted by their
+// current degree, with bin holding the start of every degree, so moving a node
+// down one degree is a constant time swap.
+func (adj *adjacency) coreDecomposition() (degree []int, vert []int) {
+	n := adj.size()
+	degree = make([]int, n)
+	pos := make([]int, n)
+	vert = make([]int, n)
+
+	maxDegree := 0
+	for u, nbrs := range adj.nbrs {
+		degree[u] = len(nbrs)
+		if degree[u] > maxDegree {
+			maxDegree = degree[u]
+		}
+	}
+
+	bin := make([]int, maxDegree+1)
+	for _, d := range degree {
+		bin[d]++
+	}
+
+	start := 0
+	for d, count := range bin {
+		bin[d] = start
+		start += count
+	}
+
+	for u, d := range degree {
+		pos[u] = bin[d]
+		vert[pos[u]] = u
+		bin[d]++
+	}
+
+	for d := maxDegree; d > 0; d-- {
+		bin[d] = bin[d-1]
+	}
+	if len(bin) != 0 {
+		bin[0] = 0
+	}
+
+	for i := 0; i < n; i++ {
+		v := vert[i]
+		for _, u := range adj.nbrs[v] {
+			if degree[u] > degree[v] {
+				du := degree[u]
+				pu := pos[u]
+				pw := bin[du]
+				w := vert[pw]
+				if u != w {
+					pos[u], pos[w] = pw, pu
+					vert[pu], vert[pw] = w, u
+				}
+				bin[du]++
+				degree[u]--
+			}
+		}
+	}
+
+	return
+}
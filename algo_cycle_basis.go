@@ -0,0 +1,283 @@
+package graph
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// CycleBasis finds a fundamental cycle basis of an undirected graph. Every edge
+// outside a breadth first spanning forest closes exactly one cycle with the
+// forest. Every cycle is returned as its edge ids in the order they are walked,
+// starting with the edge closing it.
+func (graph *Graph) CycleBasis() (basis [][]string, err error) {
+	var adj *adjacency
+	if adj, err = newUndirectedAdjacency(graph, ""); err != nil {
+		return
+	}
+
+	n := adj.size()
+	parent := filled(n, -1)
+	via := make([]*Edge, n)
+	depth := filled(n, -1)
+	tree := map[*Edge]bool{}
+
+	for root := 0; root < n; root++ {
+		if depth[root] >= 0 {
+			continue
+		}
+
+		depth[root] = 0
+		for Q := []int{root}; len(Q) != 0; {
+			v := Q[0]
+			Q = Q[1:]
+
+			for _, a := range adj.out[v] {
+				if depth[a.to] < 0 {
+					depth[a.to] = depth[v] + 1
+					parent[a.to] = v
+					via[a.to] = a.edge
+					tree[a.edge] = true
+					Q = append(Q, a.to)
+				}
+			}
+		}
+	}
+
+	for _, edge := range sortedEdges(graph) {
+		if tree[edge] {
+			continue
+		}
+
+		ends := edge.Order
+		if len(ends) != 2 {
+			err = &EdgeError{ID: edge.ID, Err: ErrNotSimpleEdge}
+			return
+		}
+
+		// walk both ends up to their lowest common ancestor.
+		u, v := adj.index[ends[0].ID], adj.index[ends[1].ID]
+		var up, down []string
+		for u != v {
+			if depth[u] >= depth[v] {
+				up = append(up, via[u].ID)
+				u = parent[u]
+			} else {
+				down = append(down, via[v].ID)
+				v = parent[v]
+			}
+		}
+
+		// the closing edge leads to the second end, back up to the ancestor and
+		// down again to the first end.
+		cycle := []string{edge.ID}
+		cycle = append(cycle, down...)
+		for i := len(up) - 1; i >= 0; i-- {
+			cycle = append(cycle, up[i])
+		}
+		basis = append(basis, cycle)
+	}
+
+	return
+}
+
+// cycleCandidate is a cycle considered for the minimum cycle basis.
+type cycleCandidate struct {
+	edges  []*Edge
+	bits   []uint64
+	weight float64
+	key    string
+}
+
+// MinimumCycleBasis finds a cycle basis of an undirected graph with the least
+// total weight, using Horton's candidate cycles: a shortest path from every node
+// to both ends of every edge. The candidates are taken cheapest first whenever
+// they are independent of the ones already chosen. Every cycle is returned as
+// its edge ids in the order they are walked. Weights must not be negative.
+func (graph *Graph) MinimumCycleBasis(weightAttr string) (basis [][]string, err error) {
+	var adj *adjacency
+	if adj, err = newUndirectedAdjacency(graph, weightAttr); err != nil {
+		return
+	}
+
+	edges := sortedEdges(graph)
+	position := map[*Edge]int{}
+	weights := map[*Edge]float64{}
+	for i, edge := range edges {
+		if len(edge.Order) != 2 {
+			err = &EdgeError{ID: edge.ID, Err: ErrNotSimpleEdge}
+			return
+		}
+
+		position[edge] = i
+		if weights[edge], err = edgeWeight(edge, weightAttr); err != nil {
+			return
+		} else if weights[edge] < 0 {
+			err = &EdgeError{ID: edge.ID, Err: ErrNegativeWeight}
+			return
+		}
+	}
+
+	words := (len(edges) + 63) / 64
+	seen := map[string]bool{}
+	var candidates []*cycleCandidate
+
+	add := func(cycle []*Edge) {
+		candidate := &cycleCandidate{edges: cycle, bits: make([]uint64, words)}
+		for _, edge := range cycle {
+			candidate.bits[position[edge]/64] ^= 1 << uint(position[edge]%64)
+			candidate.weight += weights[edge]
+		}
+
+		var parts []string
+		for _, word := range candidate.bits {
+			parts = append(parts, strconv.FormatUint(word, 16))
+		}
+		candidate.key = strings.Join(parts, ",")
+
+		if !seen[candidate.key] {
+			seen[candidate.key] = true
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	for _, edge := range edges {
+		if edge.Order[0] == edge.Order[1] {
+			add([]*Edge{edge})
+		}
+	}
+
+	for root := 0; root < adj.size(); root++ {
+		parent, via := adj.shortestPathTree(root)
+
+		// pathTo will list the nodes and edges from the root down to the node.
+		pathTo := func(u int) (nodes []int, path []*Edge) {
+			for ; u != root; u = parent[u] {
+				nodes = append(nodes, u)
+				path = append(path, via[u])
+			}
+			for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+				path[i], path[j] = path[j], path[i]
+			}
+			return
+		}
+
+		for _, edge := range edges {
+			x, y := adj.index[edge.Order[0].ID], adj.index[edge.Order[1].ID]
+			if x == y || (x != root && parent[x] < 0) || (y != root && parent[y] < 0) {
+				continue
+			} else if via[x] == edge || via[y] == edge {
+				continue
+			}
+
+			nodesX, pathX := pathTo(x)
+			nodesY, pathY := pathTo(y)
+
+			// the two paths may only share the root.
+			disjoint := true
+			onX := map[int]bool{}
+			for _, u := range nodesX {
+				onX[u] = true
+			}
+			for _, u := range nodesY {
+				disjoint = disjoint && !onX[u]
+			}
+
+			if disjoint {
+				cycle := append([]*Edge{}, pathX...)
+				cycle = append(cycle, edge)
+				for i := len(pathY) - 1; i >= 0; i-- {
+					cycle = append(cycle, pathY[i])
+				}
+				add(cycle)
+			}
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].weight != candidates[j].weight {
+			return candidates[i].weight < candidates[j].weight
+		}
+		return len(candidates[i].edges) < len(candidates[j].edges)
+	})
+
+	dimension := len(edges) - adj.size() + len(adj.components(nil))
+
+	// reduced holds the chosen cycles in row echelon form, by pivot bit.
+	reduced := map[int][]uint64{}
+	for _, candidate := range candidates {
+		if len(basis) == dimension {
+			break
+		}
+
+		row := append([]uint64{}, candidate.bits...)
+		for pivot := lowestBit(row); pivot >= 0; pivot = lowestBit(row) {
+			if other, ok := reduced[pivot]; ok {
+				for i := range row {
+					row[i] ^= other[i]
+				}
+			} else {
+				reduced[pivot] = row
+				break
+			}
+		}
+
+		if lowestBit(row) >= 0 {
+			var cycle []string
+			for _, edge := range candidate.edges {
+				cycle = append(cycle, edge.ID)
+			}
+			basis = append(basis, cycle)
+		}
+	}
+
+	return
+}
+
+// shortestPathTree will find the parent and the edge leading to every node on
+// the shortest paths from the root, -1 and nil for the root and the
+// unreachable nodes.
+func (adj *adjacency) shortestPathTree(root int) (parent []int, via []*Edge) {
+	parent = filled(adj.size(), -1)
+	via = make([]*Edge, adj.size())
+
+	dist := make([]float64, adj.size())
+	for i := range dist {
+		dist[i] = math.Inf(1)
+	}
+	dist[root] = 0
+
+	pq := &priorityQueue{}
+	for pq.push(root, 0); pq.Len() != 0; {
+		v, d := pq.pop()
+		if d > dist[v] {
+			continue
+		}
+
+		for _, a := range adj.out[v] {
+			if alt := d + a.weight; alt < dist[a.to] {
+				dist[a.to] = alt
+				parent[a.to] = v
+				via[a.to] = a.edge
+				pq.push(a.to, alt)
+			}
+		}
+	}
+
+	return
+}
+
+// lowestBit is the index of the lowest set bit, -1 when there is none.
+func lowestBit(bits []uint64) int {
+	for i, word := range bits {
+		if word != 0 {
+			for b := 0; b < 64; b++ {
+				if word&(1<<uint(b)) != 0 {
+					return i*64 + b
+				}
+			}
+		}
+	}
+	return -1
+}
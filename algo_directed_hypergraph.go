@@ -0,0 +1,76 @@
+package graph
+
+// HyperReachable finds the nodes of a directed hypergraph reachable from the
+// sources, where a hyperedge is traversed as soon as one of its tail nodes is
+// reached. It returns the nodes in the order they were reached, the sources
+// first, and the ids of the hyperedges in the order they were traversed.
+// Directed graphs are treated as hypergraphs with a single tail node.
+func (graph *Graph) HyperReachable(sources []string) (nodes []string, edges []string, err error) {
+	return graph.forwardTraversal(sources, func(edge *Edge) int {
+		return 1
+	})
+}
+
+// BConnected finds the nodes of a directed hypergraph B-connected to the
+// sources: those at the end of a forward hyperpath, where a hyperedge is only
+// traversed once all of its tail nodes are reached. It returns the nodes in the
+// order they were reached, the sources first, and the ids of the hyperedges in
+// the order they were traversed. Directed graphs are treated as hypergraphs with
+// a single tail node.
+func (graph *Graph) BConnected(sources []string) (nodes []string, edges []string, err error) {
+	return graph.forwardTraversal(sources, func(edge *Edge) int {
+		tail, _ := edge.Ends()
+		return len(tail)
+	})
+}
+
+// forwardTraversal will run a breadth first search from the sources, traversing
+// a hyperedge once the number of its tail nodes given by need are reached.
+func (graph *Graph) forwardTraversal(sources []string, need func(edge *Edge) int) (nodes []string, edges []string, err error) {
+	if err = checkKnownType(graph); err != nil {
+		return
+	} else if graph.Type != GraphDirected && graph.Type != GraphDirectedHyper {
+		err = &GraphTypeError{Type: graph.Type, Err: ErrNotDirected}
+		return
+	}
+
+	// leaving holds the hyperedges every node is a tail node of, by edge id.
+	leaving := map[string][]*Edge{}
+	for _, edge := range sortedEdges(graph) {
+		tail, _ := edge.Ends()
+		for _, node := range tail {
+			leaving[node.ID] = append(leaving[node.ID], edge)
+		}
+	}
+
+	reached := map[string]bool{}
+	for _, id := range sources {
+		if _, ok := graph.Nodes[id]; !ok {
+			err = &NodeError{ID: id, Err: ErrNodeNotFound}
+			return
+		} else if !reached[id] {
+			reached[id] = true
+			nodes = append(nodes, id)
+		}
+	}
+
+	count := map[*Edge]int{}
+	for i := 0; i < len(nodes); i++ {
+		for _, edge := range leaving[nodes[i]] {
+			if count[edge]++; count[edge] != need(edge) {
+				continue
+			}
+
+			edges = append(edges, edge.ID)
+			_, head := edge.Ends()
+			for _, node := range head {
+				if !reached[node.ID] {
+					reached[node.ID] = true
+					nodes = append(nodes, node.ID)
+				}
+			}
+		}
+	}
+
+	return
+}
@@ -0,0 +1,290 @@
+package graph
+
+import (
+	"math"
+	"sort"
+)
+
+// Eccentricity computes the largest distance from every node to any other,
+// keyed by node id. Edges are weighted by the weight attribute, where an empty
+// name counts hops, and weights must not be negative. Every node must reach
+// every other node.
+func (graph *Graph) Eccentricity(weightAttr string) (eccentricity map[string]float64, err error) {
+	var adj *adjacency
+	var values []float64
+	if adj, err = newAdjacency(graph, weightAttr); err == nil {
+		if values, err = adj.eccentricities(len(weightAttr) != 0); err == nil {
+			eccentricity = adj.scores(values)
+		}
+	}
+	return
+}
+
+// Diameter is the largest eccentricity of the graph.
+func (graph *Graph) Diameter(weightAttr string) (diameter float64, err error) {
+	var eccentricity map[string]float64
+	if eccentricity, err = graph.Eccentricity(weightAttr); err == nil {
+		for _, value := range eccentricity {
+			diameter = math.Max(diameter, value)
+		}
+	}
+	return
+}
+
+// Radius is the smallest eccentricity of the graph.
+func (graph *Graph) Radius(weightAttr string) (radius float64, err error) {
+	var eccentricity map[string]float64
+	if eccentricity, err = graph.Eccentricity(weightAttr); err == nil {
+		radius = math.Inf(1)
+		for _, value := range eccentricity {
+			radius = math.Min(radius, value)
+		}
+		if len(eccentricity) == 0 {
+			radius = 0
+		}
+	}
+	return
+}
+
+// Center returns the sorted ids of the nodes whose eccentricity is the radius.
+func (graph *Graph) Center(weightAttr string) (center []string, err error) {
+	var radius float64
+	if radius, err = graph.Radius(weightAttr); err == nil {
+		center, err = graph.withEccentricity(weightAttr, radius)
+	}
+	return
+}
+
+// Periphery returns the sorted ids of the nodes whose eccentricity is the diameter.
+func (graph *Graph) Periphery(weightAttr string) (periphery []string, err error) {
+	var diameter float64
+	if diameter, err = graph.Diameter(weightAttr); err == nil {
+		periphery, err = graph.withEccentricity(weightAttr, diameter)
+	}
+	return
+}
+
+// DoubleSweepDiameter gives a lower bound of the diameter of a connected,
+// unweighted, undirected graph with two breadth first searches: the first from
+// the highest degree node and the second from the farthest node found.
+func (graph *Graph) DoubleSweepDiameter() (lower int, err error) {
+	var adj *adjacency
+	if adj, err = newUndirectedAdjacency(graph, ""); err == nil && adj.size() != 0 {
+		_, lower, _, err = adj.doubleSweep()
+	}
+	return
+}
+
+// IFUBDiameter computes the diameter of a connected, unweighted, undirected
+// graph with the iFUB algorithm. It searches from a central node found by a
+// double sweep and then from the fringe inwards, stopping once the bounds meet.
+// A positive budget limits the number of breadth first searches, in which case
+// the bounds may still differ; otherwise lower and upper are the diameter.
+func (graph *Graph) IFUBDiameter(budget int) (lower int, upper int, err error) {
+	var adj *adjacency
+	if adj, err = newUndirectedAdjacency(graph, ""); err != nil || adj.size() == 0 {
+		return
+	}
+
+	var middle int
+	if middle, lower, _, err = adj.doubleSweep(); err != nil {
+		return
+	}
+
+	dist, _ := adj.bfsDistances(middle)
+
+	levels := map[int][]int{}
+	height := 0
+	for u, d := range dist {
+		levels[d] = append(levels[d], u)
+		if d > height {
+			height = d
+		}
+	}
+
+	if height > lower {
+		lower = height
+	}
+	upper = 2 * height
+
+	for i := height; upper > lower && i > 0; i-- {
+		for _, u := range levels[i] {
+			if budget > 0 && adj.searches >= budget {
+				return
+			}
+
+			ecc, _ := adj.bfsDistances(u)
+			for _, d := range ecc {
+				if d > lower {
+					lower = d
+				}
+			}
+		}
+
+		// every node deeper than the current fringe is done, so a longer
+		// path would have to run between two nodes above it.
+		if lower > 2*(i-1) {
+			upper = lower
+		} else {
+			upper = 2 * (i - 1)
+		}
+	}
+
+	if upper < lower {
+		upper = lower
+	}
+
+	return
+}
+
+// withEccentricity will find the nodes with the given eccentricity.
+func (graph *Graph) withEccentricity(weightAttr string, value float64) (ids []string, err error) {
+	var eccentricity map[string]float64
+	if eccentricity, err = graph.Eccentricity(weightAttr); err == nil {
+		ids = []string{}
+		for id, e := range eccentricity {
+			if e == value {
+				ids = append(ids, id)
+			}
+		}
+		sort.Strings(ids)
+	}
+	return
+}
+
+// eccentricities will compute the eccentricity of every node.
+func (adj *adjacency) eccentricities(weighted bool) (values []float64, err error) {
+	if weighted {
+		if err = adj.checkWeights(); err != nil {
+			return
+		}
+	}
+
+	values = make([]float64, adj.size())
+	for u := range values {
+		if weighted {
+			for _, d := range adj.dijkstra(u) {
+				if math.IsInf(d, 1) {
+					return nil, ErrNotConnected
+				}
+				values[u] = math.Max(values[u], d)
+			}
+		} else {
+			dist, farthest := adj.bfsDistances(u)
+			for _, d := range dist {
+				if d < 0 {
+					return nil, ErrNotConnected
+				}
+			}
+			values[u] = float64(dist[farthest])
+		}
+	}
+	return
+}
+
+// doubleSweep will search from the highest degree node and then from the
+// farthest node found, returning the node halfway along the longest path found,
+// its length and the far end.
+func (adj *adjacency) doubleSweep() (middle int, length int, far int, err error) {
+	start := 0
+	for u := range adj.nbrs {
+		if len(adj.nbrs[u]) > len(adj.nbrs[start]) {
+			start = u
+		}
+	}
+
+	dist, a := adj.bfsDistances(start)
+	for _, d := range dist {
+		if d < 0 {
+			err = ErrNotConnected
+			return
+		}
+	}
+
+	var parent []int
+	dist, far = adj.bfsDistances(a)
+	parent = adj.bfsParents(a)
+	length = dist[far]
+
+	middle = far
+	for steps := 0; steps < length/2; steps++ {
+		middle = parent[middle]
+	}
+
+	return
+}
+
+// bfsDistances will count the hops from the source to every node, -1 when
+// unreachable, returning the farthest node reached as well.
+func (adj *adjacency) bfsDistances(s int) (dist []int, farthest int) {
+	adj.searches++
+	dist = filled(adj.size(), -1)
+	dist[s] = 0
+	farthest = s
+
+	for Q := []int{s}; len(Q) != 0; {
+		v := Q[0]
+		Q = Q[1:]
+		farthest = v
+
+		for _, a := range adj.out[v] {
+			if dist[a.to] < 0 {
+				dist[a.to] = dist[v] + 1
+				Q = append(Q, a.to)
+			}
+		}
+	}
+
+	return
+}
+
+// bfsParents will find the parent of every node in the breadth first tree from
+// the source, -1 for the source and the unreachable nodes.
+func (adj *adjacency) bfsParents(s int) (parent []int) {
+	adj.searches++
+	parent = filled(adj.size(), -1)
+	seen := make([]bool, adj.size())
+	seen[s] = true
+
+	for Q := []int{s}; len(Q) != 0; {
+		v := Q[0]
+		Q = Q[1:]
+
+		for _, a := range adj.out[v] {
+			if !seen[a.to] {
+				seen[a.to] = true
+				parent[a.to] = v
+				Q = append(Q, a.to)
+			}
+		}
+	}
+
+	return
+}
+
+// dijkstra will compute the weighted distance from the source to every node,
+// infinite when unreachable.
+func (adj *adjacency) dijkstra(s int) (dist []float64) {
+	dist = make([]float64, adj.size())
+	for i := range dist {
+		dist[i] = math.Inf(1)
+	}
+	dist[s] = 0
+
+	pq := &priorityQueue{}
+	for pq.push(s, 0); pq.Len() != 0; {
+		v, d := pq.pop()
+		if d > dist[v] {
+			continue
+		}
+
+		for _, a := range adj.out[v] {
+			if alt := d + a.weight; alt < dist[a.to] {
+				dist[a.to] = alt
+				pq.push(a.to, alt)
+			}
+		}
+	}
+
+	return
+}
@@ -0,0 +1,202 @@
+package graph
+
+import (
+	"sort"
+)
+
+// DominatorTree holds the dominators of the nodes reachable from the entry of
+// a flow graph.
+type DominatorTree struct {
+
+	// Entry node the flow starts from.
+	Entry string
+
+	// Idom holds the immediate dominator of every reachable node but the entry.
+	Idom map[string]string
+
+	// Tree is the directed dominator tree, with an edge from every immediate
+	// dominator to the nodes it dominates.
+	Tree *Graph
+
+	// Frontiers holds the sorted dominance frontier of every reachable node.
+	Frontiers map[string][]string
+}
+
+// Dominators computes the dominators of a directed graph from the entry node
+// with the Lengauer-Tarjan algorithm. Nodes unreachable from the entry are left
+// out.
+func (graph *Graph) Dominators(entry string) (dominators *DominatorTree, err error) {
+	var adj *adjacency
+	if adj, err = newDirectedAdjacency(graph); err == nil {
+		dominators, err = adj.dominators(entry, adj.out, adj.in)
+	}
+	return
+}
+
+// PostDominators computes the post-dominators of a directed graph towards the
+// exit node, as the dominators of the reversed graph. The frontiers are the
+// post-dominance frontiers. Nodes that cannot reach the exit are left out.
+func (graph *Graph) PostDominators(exit string) (dominators *DominatorTree, err error) {
+	var adj *adjacency
+	if adj, err = newDirectedAdjacency(graph); err == nil {
+		dominators, err = adj.dominators(exit, adj.in, adj.out)
+	}
+	return
+}
+
+// dominators will run Lengauer-Tarjan from the entry following the successor
+// arcs, with the predecessor arcs being their reverse.
+func (adj *adjacency) dominators(entry string, succ [][]arc, pred [][]arc) (dominators *DominatorTree, err error) {
+	var root int
+	if root, err = adj.lookup(entry); err != nil {
+		return
+	}
+
+	n := adj.size()
+	semi := filled(n, -1)
+	parent := filled(n, -1)
+	idom := filled(n, -1)
+	ancestor := filled(n, -1)
+	label := make([]int, n)
+	bucket := make([][]int, n)
+	var vertex []int
+
+	// number the nodes in depth first order.
+	var number func(v int)
+	number = func(v int) {
+		semi[v] = len(vertex)
+		label[v] = v
+		vertex = append(vertex, v)
+		for _, a := range succ[v] {
+			if semi[a.to] < 0 {
+				parent[a.to] = v
+				number(a.to)
+			}
+		}
+	}
+	number(root)
+
+	// eval will find the node of minimum semi-dominator on the path to the
+	// forest root, compressing the path on the way.
+	var compress func(v int)
+	compress = func(v int) {
+		if a := ancestor[v]; ancestor[a] >= 0 {
+			compress(a)
+			if semi[label[a]] < semi[label[v]] {
+				label[v] = label[a]
+			}
+			ancestor[v] = ancestor[a]
+		}
+	}
+	eval := func(v int) int {
+		if ancestor[v] < 0 {
+			return v
+		}
+		compress(v)
+		return label[v]
+	}
+
+	for i := len(vertex) - 1; i > 0; i-- {
+		w := vertex[i]
+		for _, a := range pred[w] {
+			if semi[a.to] < 0 {
+				continue // unreachable from the entry.
+			}
+			if u := eval(a.to); semi[u] < semi[w] {
+				semi[w] = semi[u]
+			}
+		}
+		bucket[vertex[semi[w]]] = append(bucket[vertex[semi[w]]], w)
+
+		p := parent[w]
+		ancestor[w] = p
+		for _, v := range bucket[p] {
+			if u := eval(v); semi[u] < semi[v] {
+				idom[v] = u
+			} else {
+				idom[v] = p
+			}
+		}
+		bucket[p] = nil
+	}
+
+	for _, w := range vertex[1:] {
+		if idom[w] != vertex[semi[w]] {
+			idom[w] = idom[idom[w]]
+		}
+	}
+
+	dominators = &DominatorTree{
+		Entry:     entry,
+		Idom:      make(map[string]string, len(vertex)),
+		Frontiers: make(map[string][]string, len(vertex)),
+	}
+
+	if dominators.Tree, err = NewGraph(GraphDirected); err != nil {
+		return
+	}
+
+	reached := make([]int, len(vertex))
+	copy(reached, vertex)
+	sort.Ints(reached)
+
+	for _, v := range reached {
+		var node *Node
+		if node, err = dominators.Tree.AddNode(adj.ids[v]); err != nil {
+			return
+		}
+		node.Attributes = adj.graph.Nodes[adj.ids[v]].Attributes.Copy()
+	}
+
+	frontiers := make([]map[int]bool, n)
+	for _, v := range reached {
+		frontiers[v] = map[int]bool{}
+	}
+
+	for _, v := range reached {
+		if v == root {
+			continue
+		}
+
+		dominators.Idom[adj.ids[v]] = adj.ids[idom[v]]
+		if _, err = dominators.Tree.AddEdge(NewAttributeCollection(), []string{adj.ids[idom[v]], adj.ids[v]}); err != nil {
+			return
+		}
+	}
+
+	// walk up from the predecessors of every join node to its dominator.
+	for _, v := range reached {
+		var preds []int
+		for _, a := range pred[v] {
+			if semi[a.to] >= 0 {
+				preds = append(preds, a.to)
+			}
+		}
+
+		// the entry has an implicit extra predecessor, the start of the flow.
+		if len(preds) < 2 && (v != root || len(preds) < 1) {
+			continue
+		}
+
+		for _, runner := range preds {
+			for runner >= 0 && runner != idom[v] {
+				frontiers[runner][v] = true
+				if runner == root {
+					break
+				}
+				runner = idom[runner]
+			}
+		}
+	}
+
+	for _, v := range reached {
+		frontier := []string{}
+		for w := range frontiers[v] {
+			frontier = append(frontier, adj.ids[w])
+		}
+		sort.Strings(frontier)
+		dominators.Frontiers[adj.ids[v]] = frontier
+	}
+
+	return
+}
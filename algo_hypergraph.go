@@ -0,0 +1,354 @@
+package graph
+
+import "sort"
+
+// IncidenceMatrix builds the node by edge incidence matrix, the rows in node
+// order and the columns in the order of the edge ids. An entry is 1 when the
+// node is part of the edge, and 2 for the node of a loop. For directed graphs
+// and directed hypergraphs the tail nodes of every edge get -1 instead, and the
+// nodes in both its tail and its head, like the node of a loop, get 0.
+func (graph *Graph) IncidenceMatrix() (nodes []string, edges []string, matrix [][]float64, err error) {
+	if err = checkKnownType(graph); err != nil {
+		return
+	}
+
+	nodes = graph.NodeOrder()
+	index := make(map[string]int, len(nodes))
+	for i, id := range nodes {
+		index[id] = i
+	}
+
+	sorted := sortedEdges(graph)
+	matrix = make([][]float64, len(nodes))
+	for i := range matrix {
+		matrix[i] = make([]float64, len(sorted))
+	}
+
+	for j, edge := range sorted {
+		edges = append(edges, edge.ID)
+		if graph.Type == GraphDirected || graph.Type == GraphDirectedHyper {
+			tail, head := edge.Ends()
+			for _, node := range tail {
+				matrix[index[node.ID]][j]--
+			}
+			for _, node := range head {
+				matrix[index[node.ID]][j]++
+			}
+		} else {
+			for _, node := range edge.Order {
+				matrix[index[node.ID]][j]++
+			}
+		}
+	}
+
+	return
+}
+
+// NodeDegrees counts the hyperedges every node is part of, keyed by node id.
+func (graph *Graph) NodeDegrees() (degrees map[string]int, err error) {
+	if err = checkHypergraph(graph); err == nil {
+		degrees = make(map[string]int, len(graph.Nodes))
+		for id, node := range graph.Nodes {
+			degrees[id] = len(node.Edges)
+		}
+	}
+	return
+}
+
+// EdgeDegrees counts the nodes of every hyperedge, keyed by edge id.
+func (graph *Graph) EdgeDegrees() (degrees map[string]int, err error) {
+	if err = checkHypergraph(graph); err == nil {
+		degrees = make(map[string]int, len(graph.Edges))
+		for id, edge := range graph.Edges {
+			degrees[id] = len(edge.Nodes)
+		}
+	}
+	return
+}
+
+// SDistances runs a breadth first search over the s-walks of a hypergraph,
+// starting from the hyperedge. Two hyperedges are s-adjacent when they share at
+// least s nodes, and the s-distance is the length of the shortest s-walk
+// between them. The result holds the s-distance of every hyperedge reachable
+// from the source, keyed by edge id. Hyperedges with fewer than s nodes take no
+// part in s-walks. The s-walks between nodes are those of the dual hypergraph.
+func (graph *Graph) SDistances(source string, s int) (distances map[string]int, err error) {
+	var edges []*Edge
+	var links [][]int
+	if edges, links, err = graph.sAdjacency(s); err != nil {
+		return
+	}
+
+	start := -1
+	for i, edge := range edges {
+		if edge.ID == source {
+			start = i
+		}
+	}
+
+	if start < 0 {
+		if _, ok := graph.Edges[source]; ok {
+			err = &EdgeError{ID: source, Err: ErrTooSmallHyperedge}
+		} else {
+			err = &EdgeError{ID: source, Err: ErrEdgeNotFound}
+		}
+		return
+	}
+
+	distances = map[string]int{source: 0}
+	for Q := []int{start}; len(Q) != 0; Q = Q[1:] {
+		u := Q[0]
+		for _, v := range links[u] {
+			if _, ok := distances[edges[v].ID]; !ok {
+				distances[edges[v].ID] = distances[edges[u].ID] + 1
+				Q = append(Q, v)
+			}
+		}
+	}
+
+	return
+}
+
+// SComponents finds the s-connected components of a hypergraph: the groups of
+// hyperedges joined by s-walks. Every component is sorted by edge id and the
+// components are sorted by their first edge id. Hyperedges with fewer than s
+// nodes are left out.
+func (graph *Graph) SComponents(s int) (components [][]string, err error) {
+	var edges []*Edge
+	var links [][]int
+	if edges, links, err = graph.sAdjacency(s); err != nil {
+		return
+	}
+
+	seen := make([]bool, len(edges))
+	for start := range edges {
+		if seen[start] {
+			continue
+		}
+
+		seen[start] = true
+		component := []int{start}
+		for i := 0; i < len(component); i++ {
+			for _, v := range links[component[i]] {
+				if !seen[v] {
+					seen[v] = true
+					component = append(component, v)
+				}
+			}
+		}
+
+		sort.Ints(component)
+		var ids []string
+		for _, u := range component {
+			ids = append(ids, edges[u].ID)
+		}
+		components = append(components, ids)
+	}
+
+	return
+}
+
+// sAdjacency will link every hyperedge, of at least s nodes, to the hyperedges
+// sharing at least s nodes with it. The hyperedges are in the order of their ids.
+func (graph *Graph) sAdjacency(s int) (edges []*Edge, links [][]int, err error) {
+	if err = checkHypergraph(graph); err != nil {
+		return
+	} else if s < 1 {
+		err = ErrInvalidS
+		return
+	}
+
+	for _, edge := range sortedEdges(graph) {
+		if len(edge.Nodes) >= s {
+			edges = append(edges, edge)
+		}
+	}
+
+	index := make(map[*Edge]int, len(edges))
+	for i, edge := range edges {
+		index[edge] = i
+	}
+
+	links = make([][]int, len(edges))
+	for u, edge := range edges {
+		shared := map[int]int{}
+		for _, node := range edge.Nodes {
+			for _, other := range node.Edges {
+				if v, ok := index[other]; ok && v != u {
+					shared[v]++
+				}
+			}
+		}
+
+		for v, count := range shared {
+			if count >= s {
+				links[u] = append(links[u], v)
+			}
+		}
+		sort.Ints(links[u])
+	}
+
+	return
+}
+
+// Dual creates the dual hypergraph, swapping the roles of the nodes and the
+// hyperedges. Every hyperedge becomes a node and every node becomes a hyperedge
+// joining the hyperedges it was part of, both keeping their ids and a copy of
+// their attributes. A node part of a single hyperedge becomes a hyperedge of
+// one node, and a node part of none an empty hyperedge, so the incidence matrix
+// of the dual is the transpose of the original one.
+func (graph *Graph) Dual() (dual *Graph, err error) {
+	if err = checkHypergraph(graph); err != nil {
+		return
+	}
+
+	if dual, err = NewGraph(GraphHyper); err != nil {
+		return
+	}
+	dual.Attributes = graph.Attributes.Copy()
+
+	for _, edge := range sortedEdges(graph) {
+		var node *Node
+		if node, err = dual.AddNode(edge.ID); err != nil {
+			return
+		}
+		node.Attributes = edge.Attributes.Copy()
+	}
+
+	for _, id := range graph.NodeOrder() {
+		node := graph.Nodes[id]
+		var members []string
+		for edgeID := range node.Edges {
+			members = append(members, edgeID)
+		}
+		sort.Strings(members)
+
+		attrs := node.Attributes.Copy()
+		attrs.Set("id", id)
+		if _, err = dual.AddEdge(attrs, members); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// CliqueExpansion creates the undirected graph linking every pair of nodes that
+// share a hyperedge. The nodes keep their ids and a copy of their attributes,
+// and every edge has a 'weight' attribute counting the hyperedges the pair
+// shares. The edges have the default ids, smallest node id first, followed by
+// '#2', '#3', ... when node ids containing '-' make them clash.
+func (graph *Graph) CliqueExpansion() (expansion *Graph, err error) {
+	var adj *adjacency
+	if err = checkHypergraph(graph); err != nil {
+		return
+	} else if adj, err = newAdjacency(graph, ""); err != nil {
+		return
+	}
+
+	if expansion, err = graph.expansionNodes(); err != nil {
+		return
+	}
+
+	for u, arcs := range adj.out {
+		shared := map[int]int{}
+		for _, a := range arcs {
+			if a.to > u {
+				shared[a.to]++
+			}
+		}
+
+		for _, v := range adj.nbrs[u] {
+			if shared[v] != 0 {
+				attrs := NewAttributeCollection()
+				attrs.Set("id", expansion.freeEdgeID(adj.ids[u]+"-"+adj.ids[v]))
+				attrs.Set("weight", shared[v])
+				if _, err = expansion.AddEdge(attrs, []string{adj.ids[u], adj.ids[v]}); err != nil {
+					return
+				}
+			}
+		}
+	}
+
+	return
+}
+
+// StarExpansion creates the bipartite undirected graph with a node for every
+// node and for every hyperedge of the hypergraph, linking every hyperedge to
+// its nodes. All keep their ids and a copy of their attributes, and a
+// 'bipartite' attribute tells the nodes, set 0, from the hyperedges, set 1. The
+// edges have the default ids, node id first, followed by '#2', '#3', ... when
+// ids containing '-' make them clash.
+func (graph *Graph) StarExpansion() (expansion *Graph, err error) {
+	if err = checkHypergraph(graph); err != nil {
+		return
+	}
+
+	if expansion, err = graph.expansionNodes(); err != nil {
+		return
+	}
+
+	for _, node := range expansion.Nodes {
+		node.Attributes.Set("bipartite", 0)
+	}
+
+	edges := sortedEdges(graph)
+	for _, edge := range edges {
+		if _, ok := graph.Nodes[edge.ID]; ok {
+			err = &EdgeError{ID: edge.ID, Err: ErrSharedID}
+			return
+		}
+
+		var node *Node
+		if node, err = expansion.AddNode(edge.ID); err != nil {
+			return
+		}
+		node.Attributes = edge.Attributes.Copy()
+		node.Attributes.Set("bipartite", 1)
+	}
+
+	for _, edge := range edges {
+		var members []string
+		for id := range edge.Nodes {
+			members = append(members, id)
+		}
+		sort.Strings(members)
+
+		for _, id := range members {
+			attrs := NewAttributeCollection()
+			attrs.Set("id", expansion.freeEdgeID(id+"-"+edge.ID))
+			if _, err = expansion.AddEdge(attrs, []string{id, edge.ID}); err != nil {
+				return
+			}
+		}
+	}
+
+	return
+}
+
+// expansionNodes will create an undirected graph holding a copy of the nodes.
+func (graph *Graph) expansionNodes() (expansion *Graph, err error) {
+	if expansion, err = NewGraph(GraphUndirected); err != nil {
+		return
+	}
+	expansion.Attributes = graph.Attributes.Copy()
+
+	for _, id := range graph.NodeOrder() {
+		var node *Node
+		if node, err = expansion.AddNode(id); err != nil {
+			return
+		}
+		node.Attributes = graph.Nodes[id].Attributes.Copy()
+	}
+
+	return
+}
+
+// checkHypergraph will accept hypergraphs and undirected graphs, the latter
+// being hypergraphs with edges of two nodes.
+func checkHypergraph(graph *Graph) (err error) {
+	if err = checkKnownType(graph); err == nil && (graph.Type == GraphDirected || graph.Type == GraphDirectedHyper) {
+		err = &GraphTypeError{Type: graph.Type, Err: ErrNotUndirected}
+	}
+	return
+}
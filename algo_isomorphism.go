@@ -0,0 +1,391 @@
+package graph
+
+import "reflect"
+
+// MatchOptions holds the optional compatibility predicates of the matcher. A
+// nil predicate accepts every pair.
+type MatchOptions struct {
+
+	// NodeMatch reports if a node of the first graph may map to a node of the
+	// second graph.
+	NodeMatch func(a AttributeCollection, b AttributeCollection) bool
+
+	// EdgeMatch reports if an edge of the first graph may map to an edge of
+	// the second graph.
+	EdgeMatch func(a AttributeCollection, b AttributeCollection) bool
+}
+
+// MatchAttributes builds a predicate accepting the attribute collections that
+// agree on all the named attributes, a missing attribute only matching another
+// missing attribute.
+func MatchAttributes(names ...string) func(a AttributeCollection, b AttributeCollection) bool {
+	return func(a AttributeCollection, b AttributeCollection) bool {
+		for _, name := range names {
+			va, oka := a.Get(name)
+			vb, okb := b.Get(name)
+			if oka != okb || !reflect.DeepEqual(va, vb) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// IsIsomorphic checks if the graphs are isomorphic with the VF2 algorithm. When
+// they are, the mapping gives the node id of the other graph for every node id
+// of this graph.
+func (graph *Graph) IsIsomorphic(other *Graph, options *MatchOptions) (isomorphic bool, mapping map[string]string, err error) {
+	if len(graph.Nodes) != len(other.Nodes) || len(graph.Edges) != len(other.Edges) {
+		return
+	}
+
+	err = graph.matchGraphs(other, options, false, func(found map[string]string) (cont bool, err error) {
+		isomorphic = true
+		mapping = found
+		return
+	})
+
+	return
+}
+
+// SubgraphIsomorphisms finds every occurrence of the pattern as a node induced
+// subgraph of this graph with the VF2 algorithm. Every match is passed to the
+// iteration function as a mapping from the pattern node ids to the node ids of
+// this graph; the search stops when the function returns false or an error.
+func (graph *Graph) SubgraphIsomorphisms(pattern *Graph, options *MatchOptions, iterFunc func(mapping map[string]string) (cont bool, err error)) (err error) {
+	if iterFunc == nil {
+		return ErrNoIterationFunc
+	}
+
+	return pattern.matchGraphs(graph, options, true, iterFunc)
+}
+
+// vf2State is the search state of the VF2 matcher, mapping the nodes of the
+// small graph g1 into the large graph g2.
+type vf2State struct {
+	g1, g2 *adjacency
+
+	// links counts the arcs between every ordered pair of nodes.
+	links1, links2 []map[int]int
+
+	// core holds the current mapping in both directions, -1 when unmapped.
+	core1, core2 []int
+
+	// out and in hold the depth at which a node entered the terminal sets of
+	// successors and predecessors, 0 while outside.
+	out1, out2, in1, in2 []int
+
+	options  *MatchOptions
+	subgraph bool
+	order    []int
+	depth    int
+}
+
+// matchGraphs will run the VF2 search of this graph in the target graph.
+func (graph *Graph) matchGraphs(target *Graph, options *MatchOptions, subgraph bool, iterFunc func(mapping map[string]string) (cont bool, err error)) (err error) {
+	if options == nil {
+		options = &MatchOptions{}
+	}
+
+	var g1, g2 *adjacency
+	if g1, err = newAdjacency(graph, ""); err != nil {
+		return
+	} else if g2, err = newAdjacency(target, ""); err != nil {
+		return
+	} else if g1.directed != g2.directed {
+		return &GraphTypeError{Type: target.Type, Err: ErrMixedDirection}
+	}
+
+	state := &vf2State{
+		g1:       g1,
+		g2:       g2,
+		links1:   g1.links(),
+		links2:   g2.links(),
+		core1:    filled(g1.size(), -1),
+		core2:    filled(g2.size(), -1),
+		out1:     make([]int, g1.size()),
+		out2:     make([]int, g2.size()),
+		in1:      make([]int, g1.size()),
+		in2:      make([]int, g2.size()),
+		options:  options,
+		subgraph: subgraph,
+	}
+
+	state.order = state.matchOrder()
+
+	state.search(func(mapping []int) bool {
+		found := make(map[string]string, len(mapping))
+		for u, v := range mapping {
+			found[g1.ids[u]] = g2.ids[v]
+		}
+
+		var cont bool
+		cont, err = iterFunc(found)
+		return cont && err == nil
+	})
+
+	return
+}
+
+// links will count the arcs between every ordered pair of nodes.
+func (adj *adjacency) links() (links []map[int]int) {
+	links = make([]map[int]int, adj.size())
+	for u, arcs := range adj.out {
+		links[u] = map[int]int{}
+		for _, arc := range arcs {
+			links[u][arc.to]++
+		}
+	}
+	return
+}
+
+// filled will make a slice holding the value n times.
+func filled(n int, value int) (values []int) {
+	values = make([]int, n)
+	for i := range values {
+		values[i] = value
+	}
+	return
+}
+
+// matchOrder will order the nodes of g1 so every node, after the first of its
+// component, is connected to an earlier one, preferring the most connected.
+func (s *vf2State) matchOrder() (order []int) {
+	n := s.g1.size()
+	placed := make([]bool, n)
+
+	for len(order) < n {
+		// start a new component from its highest degree node.
+		start := -1
+		for u := 0; u < n; u++ {
+			if !placed[u] && (start < 0 || len(s.g1.nbrs[u]) > len(s.g1.nbrs[start])) {
+				start = u
+			}
+		}
+
+		placed[start] = true
+		order = append(order, start)
+		for i := len(order) - 1; i < len(order); i++ {
+			for _, v := range s.g1.nbrs[order[i]] {
+				if !placed[v] {
+					placed[v] = true
+					order = append(order, v)
+				}
+			}
+		}
+	}
+
+	return
+}
+
+// search will extend the mapping, returning false once visit asks to stop.
+func (s *vf2State) search(visit func(mapping []int) bool) bool {
+	if s.depth == len(s.order) {
+		return visit(append([]int{}, s.core1...))
+	}
+
+	u := s.order[s.depth]
+
+	// a node linked to the mapping can only map next to its mapped neighbour.
+	var candidates []int
+	for _, w := range s.g1.nbrs[u] {
+		if s.core1[w] >= 0 {
+			candidates = s.g2.nbrs[s.core1[w]]
+			break
+		}
+	}
+	if candidates == nil {
+		candidates = make([]int, s.g2.size())
+		for v := range candidates {
+			candidates[v] = v
+		}
+	}
+
+	for _, v := range candidates {
+		if s.core2[v] >= 0 || !s.feasible(u, v) {
+			continue
+		}
+
+		s.push(u, v)
+		cont := s.search(visit)
+		s.pop(u, v)
+
+		if !cont {
+			return false
+		}
+	}
+
+	return true
+}
+
+// feasible will check if u of g1 can be mapped to v of g2.
+func (s *vf2State) feasible(u int, v int) bool {
+	if s.options.NodeMatch != nil && !s.options.NodeMatch(s.g1.graph.Nodes[s.g1.ids[u]].Attributes, s.g2.graph.Nodes[s.g2.ids[v]].Attributes) {
+		return false
+	}
+
+	// loops must agree.
+	if !s.compatible(s.links1[u][u], s.links2[v][v]) {
+		return false
+	}
+
+	// the degrees must leave room for the mapping.
+	if s.subgraph {
+		if len(s.g1.out[u]) > len(s.g2.out[v]) || len(s.g1.in[u]) > len(s.g2.in[v]) {
+			return false
+		}
+	} else if len(s.g1.out[u]) != len(s.g2.out[v]) || len(s.g1.in[u]) != len(s.g2.in[v]) {
+		return false
+	}
+
+	// every mapped neighbour must be linked the same way.
+	for _, arcs := range [][]arc{s.g1.out[u], s.g1.in[u]} {
+		for _, a := range arcs {
+			if w := s.core1[a.to]; w >= 0 && a.to != u {
+				if !s.compatible(s.links1[u][a.to], s.links2[v][w]) || !s.compatible(s.links1[a.to][u], s.links2[w][v]) {
+					return false
+				}
+			}
+		}
+	}
+
+	for _, arcs := range [][]arc{s.g2.out[v], s.g2.in[v]} {
+		for _, a := range arcs {
+			if w := s.core2[a.to]; w >= 0 && a.to != v {
+				if !s.compatible(s.links1[u][w], s.links2[v][a.to]) || !s.compatible(s.links1[w][u], s.links2[a.to][v]) {
+					return false
+				}
+			}
+		}
+	}
+
+	if s.options.EdgeMatch != nil && !s.edgesMatch(u, v) {
+		return false
+	}
+
+	// look ahead at the terminal sets and the unexplored nodes.
+	out1, in1, new1 := s.lookahead(s.g1, s.core1, s.out1, s.in1, u)
+	out2, in2, new2 := s.lookahead(s.g2, s.core2, s.out2, s.in2, v)
+
+	if s.subgraph {
+		return out1 <= out2 && in1 <= in2 && new1 <= new2
+	}
+	return out1 == out2 && in1 == in2 && new1 == new2
+}
+
+// compatible will compare the arc counts between two mapped node pairs.
+func (s *vf2State) compatible(count1 int, count2 int) bool {
+	return count1 == count2
+}
+
+// edgesMatch will compare the edges between u and its mapped neighbours with
+// the edges between v and theirs.
+func (s *vf2State) edgesMatch(u int, v int) bool {
+	for _, a := range s.g1.out[u] {
+		if w := s.core1[a.to]; w >= 0 || a.to == u {
+			if a.to == u {
+				w = v
+			}
+			if !s.anyEdgeMatches(a.edge, s.g2.out[v], w) {
+				return false
+			}
+		}
+	}
+
+	if s.g1.directed {
+		for _, a := range s.g1.in[u] {
+			if w := s.core1[a.to]; w >= 0 {
+				if !s.anyEdgeMatches(a.edge, s.g2.in[v], w) {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// anyEdgeMatches will check if one of the arcs to the target carries a
+// compatible edge.
+func (s *vf2State) anyEdgeMatches(edge *Edge, arcs []arc, target int) bool {
+	for _, a := range arcs {
+		if a.to == target && s.options.EdgeMatch(edge.Attributes, a.edge.Attributes) {
+			return true
+		}
+	}
+	return false
+}
+
+// lookahead will count the unmapped neighbours of the node in the successor and
+// predecessor terminal sets and outside of them.
+func (s *vf2State) lookahead(g *adjacency, core []int, out []int, in []int, u int) (outCount int, inCount int, newCount int) {
+	for _, w := range g.nbrs[u] {
+		if core[w] >= 0 {
+			continue
+		}
+
+		if out[w] > 0 {
+			outCount++
+		}
+		if in[w] > 0 {
+			inCount++
+		}
+		if out[w] == 0 && in[w] == 0 {
+			newCount++
+		}
+	}
+	return
+}
+
+// push will add the pair to the mapping and grow the terminal sets.
+func (s *vf2State) push(u int, v int) {
+	s.depth++
+	s.core1[u] = v
+	s.core2[v] = u
+
+	s.grow(s.g1, s.out1, s.in1, u)
+	s.grow(s.g2, s.out2, s.in2, v)
+}
+
+// grow will add the neighbours of the newly mapped node to the terminal sets.
+func (s *vf2State) grow(g *adjacency, out []int, in []int, u int) {
+	if out[u] == 0 {
+		out[u] = s.depth
+	}
+	if in[u] == 0 {
+		in[u] = s.depth
+	}
+
+	for _, a := range g.out[u] {
+		if out[a.to] == 0 {
+			out[a.to] = s.depth
+		}
+	}
+	for _, a := range g.in[u] {
+		if in[a.to] == 0 {
+			in[a.to] = s.depth
+		}
+	}
+}
+
+// pop will remove the pair from the mapping and restore the terminal sets.
+func (s *vf2State) pop(u int, v int) {
+	s.shrink(s.out1, s.in1)
+	s.shrink(s.out2, s.in2)
+
+	s.core1[u] = -1
+	s.core2[v] = -1
+	s.depth--
+}
+
+// shrink will drop the nodes added to the terminal sets at the current depth.
+func (s *vf2State) shrink(out []int, in []int) {
+	for i := range out {
+		if out[i] == s.depth {
+			out[i] = 0
+		}
+		if in[i] == s.depth {
+			in[i] = 0
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package graph
+
+import (
+	"math"
+	"sort"
+	"strings"
+)
+
+// Path is a walk through the graph.
+type Path struct {
+
+	// Nodes visited, from the source to the target.
+	Nodes []string
+
+	// Edges followed, one less than the nodes.
+	Edges []string
+
+	// Cost is the total weight of the edges.
+	Cost float64
+}
+
+// PathIterator lazily produces the loopless paths between two nodes in order
+// of increasing cost.
+type PathIterator struct {
+	adj    *adjacency
+	source int
+	target int
+
+	// found holds the paths returned so far.
+	found []*yenPath
+
+	// candidates waiting to be returned, with their keys to avoid duplicates.
+	candidates []*yenPath
+	seen       map[string]bool
+
+	done bool
+}
+
+// yenPath is a path through the snapshot.
+type yenPath struct {
+	nodes []int
+	arcs  []arc
+	cost  float64
+	key   string
+}
+
+// KShortestPaths will iterate over the loopless paths from the source to the
+// target, cheapest first, with Yen's algorithm. Edges are weighted by the
+// weight attribute, where an empty name counts hops, and weights must not be
+// negative. Parallel edges give distinct paths. The next path is only searched
+// for when asked, so callers can stop as soon as they have enough.
+func (graph *Graph) KShortestPaths(source string, target string, weightAttr string) (paths *PathIterator, err error) {
+	var adj *adjacency
+	if adj, err = newWeightedAdjacency(graph, weightAttr); err != nil {
+		return
+	}
+
+	paths = &PathIterator{adj: adj, seen: map[string]bool{}}
+	if paths.source, err = adj.lookup(source); err != nil {
+		return nil, err
+	} else if paths.target, err = adj.lookup(target); err != nil {
+		return nil, err
+	}
+
+	return
+}
+
+// Next will return the next cheapest path, or false once there are no more.
+func (paths *PathIterator) Next() (path *Path, ok bool) {
+	if paths.done {
+		return
+	}
+
+	if len(paths.found) == 0 {
+		if first, found := paths.adj.shortestPath(paths.source, paths.target, make([]bool, paths.adj.size()), nil); found {
+			paths.add(first)
+		}
+	} else {
+		paths.spur(paths.found[len(paths.found)-1])
+	}
+
+	if len(paths.candidates) == 0 {
+		paths.done = true
+		return
+	}
+
+	sort.SliceStable(paths.candidates, func(i, j int) bool {
+		a, b := paths.candidates[i], paths.candidates[j]
+		if a.cost != b.cost {
+			return a.cost < b.cost
+		} else if len(a.arcs) != len(b.arcs) {
+			return len(a.arcs) < len(b.arcs)
+		}
+		return a.key < b.key
+	})
+
+	next := paths.candidates[0]
+	paths.candidates = paths.candidates[1:]
+	paths.found = append(paths.found, next)
+
+	path = &Path{Cost: next.cost}
+	for _, u := range next.nodes {
+		path.Nodes = append(path.Nodes, paths.adj.ids[u])
+	}
+	for _, a := range next.arcs {
+		path.Edges = append(path.Edges, a.edge.ID)
+	}
+
+	return path, true
+}
+
+// spur will add the deviations from every node of the last path found.
+func (paths *PathIterator) spur(last *yenPath) {
+	for i := 0; i < len(last.nodes)-1; i++ {
+		spurNode := last.nodes[i]
+
+		// forbid the edges leaving the shared root of the paths found so far.
+		removedEdges := map[*Edge]bool{}
+		for _, p := range paths.found {
+			if len(p.arcs) > i && sameArcs(p.arcs[:i], last.arcs[:i]) {
+				removedEdges[p.arcs[i].edge] = true
+			}
+		}
+
+		// and the root itself, so the path stays loopless.
+		removedNodes := make([]bool, paths.adj.size())
+		for _, u := range last.nodes[:i] {
+			removedNodes[u] = true
+		}
+
+		if spurPath, found := paths.adj.shortestPath(spurNode, paths.target, removedNodes, removedEdges); found {
+			total := &yenPath{
+				nodes: append(append([]int{}, last.nodes[:i]...), spurPath.nodes...),
+				arcs:  append(append([]arc{}, last.arcs[:i]...), spurPath.arcs...),
+			}
+			for _, a := range total.arcs {
+				total.cost += a.weight
+			}
+			paths.add(total)
+		}
+	}
+}
+
+// add will queue the candidate unless it was already seen.
+func (paths *PathIterator) add(path *yenPath) {
+	var ids []string
+	for _, a := range path.arcs {
+		ids = append(ids, a.edge.ID)
+	}
+	path.key = paths.adj.ids[path.nodes[0]] + ":" + strings.Join(ids, "\x00")
+
+	if !paths.seen[path.key] {
+		paths.seen[path.key] = true
+		paths.candidates = append(paths.candidates, path)
+	}
+}
+
+// sameArcs will check if the arcs follow the same edges.
+func sameArcs(a []arc, b []arc) bool {
+	for i := range a {
+		if a[i].edge != b[i].edge || a[i].to != b[i].to {
+			return false
+		}
+	}
+	return true
+}
+
+// shortestPath will find the cheapest path from the source to the target,
+// avoiding the removed nodes and edges.
+func (adj *adjacency) shortestPath(s int, t int, removedNodes []bool, removedEdges map[*Edge]bool) (path *yenPath, found bool) {
+	dist := make([]float64, adj.size())
+	for i := range dist {
+		dist[i] = math.Inf(1)
+	}
+	via := make([]*arc, adj.size())
+	from := filled(adj.size(), -1)
+	dist[s] = 0
+
+	pq := &priorityQueue{}
+	for pq.push(s, 0); pq.Len() != 0; {
+		v, d := pq.pop()
+		if d > dist[v] {
+			continue
+		} else if v == t {
+			break
+		}
+
+		for i := range adj.out[v] {
+			a := &adj.out[v][i]
+			if removedNodes[a.to] || removedEdges[a.edge] || a.to == v {
+				continue
+			}
+
+			if alt := d + a.weight; alt < dist[a.to] {
+				dist[a.to] = alt
+				via[a.to] = a
+				from[a.to] = v
+				pq.push(a.to, alt)
+			}
+		}
+	}
+
+	if math.IsInf(dist[t], 1) {
+		return
+	}
+
+	path = &yenPath{cost: dist[t]}
+	for v := t; v != s; v = from[v] {
+		path.nodes = append(path.nodes, v)
+		path.arcs = append(path.arcs, *via[v])
+	}
+	path.nodes = append(path.nodes, s)
+
+	for i, j := 0, len(path.nodes)-1; i < j; i, j = i+1, j-1 {
+		path.nodes[i], path.nodes[j] = path.nodes[j], path.nodes[i]
+	}
+	for i, j := 0, len(path.arcs)-1; i < j; i, j = i+1, j-1 {
+		path.arcs[i], path.arcs[j] = path.arcs[j], path.arcs[i]
+	}
+
+	return path, true
+}
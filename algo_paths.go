@@ -0,0 +1,260 @@
+package graph
+
+// AllSimplePaths enumerates every path from the source to the target that
+// visits no node twice, following at most maxLen edges when maxLen is
+// positive. Parallel edges give distinct paths and the cost of every path is
+// its number of edges. The paths are passed to the iteration function as they
+// are found; the enumeration stops when it returns false or an error.
+func (graph *Graph) AllSimplePaths(source string, target string, maxLen int, iterFunc func(path *Path) (cont bool, err error)) (err error) {
+	if iterFunc == nil {
+		return ErrNoIterationFunc
+	}
+
+	var adj *adjacency
+	var s, t int
+	if adj, err = newAdjacency(graph, ""); err != nil {
+		return
+	} else if s, err = adj.lookup(source); err != nil {
+		return
+	} else if t, err = adj.lookup(target); err != nil {
+		return
+	}
+
+	visited := make([]bool, adj.size())
+	nodes := []string{source}
+	var edges []string
+
+	var walk func(v int) bool
+	walk = func(v int) bool {
+		if v == t && len(edges) != 0 {
+			path := &Path{
+				Nodes: append([]string{}, nodes...),
+				Edges: append([]string{}, edges...),
+				Cost:  float64(len(edges)),
+			}
+
+			var cont bool
+			cont, err = iterFunc(path)
+			return cont && err == nil
+		}
+
+		if maxLen > 0 && len(edges) >= maxLen {
+			return true
+		}
+
+		visited[v] = true
+		for _, a := range adj.out[v] {
+			if visited[a.to] {
+				continue
+			}
+
+			nodes = append(nodes, adj.ids[a.to])
+			edges = append(edges, a.edge.ID)
+			cont := walk(a.to)
+			nodes = nodes[:len(nodes)-1]
+			edges = edges[:len(edges)-1]
+
+			if !cont {
+				return false
+			}
+		}
+		visited[v] = false
+
+		return true
+	}
+
+	walk(s)
+	return
+}
+
+// SimpleCycles enumerates the elementary cycles of the graph: closed walks that
+// visit no node twice. Directed graphs use Johnson's algorithm. Loops are
+// cycles of a single node, reported once per loop, and every pair of parallel
+// undirected edges is a cycle of two nodes. Otherwise undirected cycles need at
+// least three nodes and are only reported once, not once per direction, however
+// many parallel edges join their nodes. Every cycle is passed to the iteration
+// function as its node ids, starting from the smallest; the enumeration stops
+// when it returns false or an error.
+func (graph *Graph) SimpleCycles(iterFunc func(cycle []string) (cont bool, err error)) (err error) {
+	if iterFunc == nil {
+		return ErrNoIterationFunc
+	}
+
+	var adj *adjacency
+	if adj, err = newAdjacency(graph, ""); err != nil {
+		return
+	}
+
+	visit := func(cycle []int) bool {
+		ids := make([]string, len(cycle))
+		for i, u := range cycle {
+			ids[i] = adj.ids[u]
+		}
+
+		var cont bool
+		cont, err = iterFunc(ids)
+		return cont && err == nil
+	}
+
+	if adj.directed {
+		adj.johnson(visit)
+	} else {
+		adj.undirectedCycles(visit)
+	}
+
+	return
+}
+
+// johnson will enumerate the elementary cycles of a directed graph. Every cycle
+// is found from its smallest node, searching only the strongly connected
+// component of that node among the nodes not smaller than it. Nodes are
+// blocked once they are known not to lead back to the start, until a cycle is
+// found through them.
+func (adj *adjacency) johnson(visit func(cycle []int) bool) {
+	n := adj.size()
+	blocked := make([]bool, n)
+	blockers := make([]map[int]bool, n)
+
+	var unblock func(u int)
+	unblock = func(u int) {
+		blocked[u] = false
+		for w := range blockers[u] {
+			delete(blockers[u], w)
+			if blocked[w] {
+				unblock(w)
+			}
+		}
+	}
+
+	for s := 0; s < n; s++ {
+		allowed := make([]bool, n)
+		for u := s; u < n; u++ {
+			allowed[u] = true
+		}
+
+		var component []bool
+		for _, c := range adj.stronglyConnected(allowed) {
+			for _, u := range c {
+				if u == s {
+					component = make([]bool, n)
+					for _, w := range c {
+						component[w] = true
+					}
+				}
+			}
+		}
+
+		for u := s; u < n; u++ {
+			blocked[u] = false
+			blockers[u] = map[int]bool{}
+		}
+
+		var stack []int
+		stopped := false
+
+		var circuit func(v int) bool
+		circuit = func(v int) (found bool) {
+			stack = append(stack, v)
+			blocked[v] = true
+
+			seen := map[int]bool{}
+			for _, a := range adj.out[v] {
+				w := a.to
+				if !component[w] || seen[w] {
+					continue
+				}
+				seen[w] = true
+
+				if w == s {
+					found = true
+					if !visit(append([]int{}, stack...)) {
+						stopped = true
+					}
+				} else if !blocked[w] && circuit(w) {
+					found = true
+				}
+
+				if stopped {
+					return
+				}
+			}
+
+			if found {
+				unblock(v)
+			} else {
+				for w := range seen {
+					blockers[w][v] = true
+				}
+			}
+
+			stack = stack[:len(stack)-1]
+			return
+		}
+
+		circuit(s)
+		if stopped {
+			return
+		}
+	}
+}
+
+// undirectedCycles will enumerate the cycles of an undirected graph from their
+// smallest node, only accepting the direction whose second node is smaller
+// than the last one. Every loop and every pair of parallel edges is a cycle of
+// its own, while the longer cycles are told apart by their nodes alone.
+func (adj *adjacency) undirectedCycles(visit func(cycle []int) bool) {
+	n := adj.size()
+	onPath := make([]bool, n)
+
+	for s := 0; s < n; s++ {
+		parallel := map[int]int{}
+		for _, a := range adj.out[s] {
+			if a.to == s {
+				if !visit([]int{s}) {
+					return
+				}
+			} else if a.to > s {
+				parallel[a.to]++
+			}
+		}
+
+		for _, w := range adj.nbrs[s] {
+			for pairs := parallel[w] * (parallel[w] - 1) / 2; pairs > 0; pairs-- {
+				if !visit([]int{s, w}) {
+					return
+				}
+			}
+		}
+
+		stack := []int{s}
+		onPath[s] = true
+
+		var walk func(v int) bool
+		walk = func(v int) bool {
+			for _, w := range adj.nbrs[v] {
+				if w == s && len(stack) > 2 && stack[1] < v {
+					if !visit(append([]int{}, stack...)) {
+						return false
+					}
+				} else if w > s && !onPath[w] {
+					onPath[w] = true
+					stack = append(stack, w)
+					cont := walk(w)
+					stack = stack[:len(stack)-1]
+					onPath[w] = false
+
+					if !cont {
+						return false
+					}
+				}
+			}
+			return true
+		}
+
+		cont := walk(s)
+		onPath[s] = false
+		if !cont {
+			return
+		}
+	}
+}
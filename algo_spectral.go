@@ -0,0 +1,501 @@
+package graph
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// spectralTolerance is the residual, relative to the spectral bound, at which an
+// eigenpair is accepted.
+const spectralTolerance = 1.0e-10
+
+// AdjacencyMatrix builds the weighted adjacency matrix in node order. Parallel
+// edges are summed and for directed graphs row u holds the edges leaving u. In
+// an undirected graph a loop adds twice its weight to the diagonal, as it adds
+// two to the degree of its node, while in a directed graph it adds its weight
+// once, as it leaves its node once.
+func (graph *Graph) AdjacencyMatrix(weightAttr string) (order []string, matrix [][]float64, err error) {
+	var adj *adjacency
+	if adj, err = newAdjacency(graph, weightAttr); err == nil {
+		order = adj.ids
+		matrix = adj.adjacencyMatrix()
+	}
+	return
+}
+
+// DegreeMatrix builds the diagonal matrix of the weighted degrees in node
+// order, the row sums of the adjacency matrix, so a loop counts twice like in
+// Node.Degree. For directed graphs the out-degree is used.
+func (graph *Graph) DegreeMatrix(weightAttr string) (order []string, matrix [][]float64, err error) {
+	var adj *adjacency
+	if adj, err = newAdjacency(graph, weightAttr); err == nil {
+		order = adj.ids
+		matrix = newMatrix(adj.size())
+		for u, row := range adj.adjacencyMatrix() {
+			for _, value := range row {
+				matrix[u][u] += value
+			}
+		}
+	}
+	return
+}
+
+// LaplacianMatrix builds the Laplacian D - A in node order. For directed graphs
+// the out-degree is used. A loop adds as much to D as to A, so loops leave the
+// Laplacian unchanged.
+func (graph *Graph) LaplacianMatrix(weightAttr string) (order []string, matrix [][]float64, err error) {
+	var adj *adjacency
+	if adj, err = newAdjacency(graph, weightAttr); err == nil {
+		order = adj.ids
+		matrix = adj.laplacian(false)
+	}
+	return
+}
+
+// NormalizedLaplacianMatrix builds the symmetric normalized Laplacian
+// I - D^-1/2 A D^-1/2 of an undirected graph in node order. Isolated nodes get
+// an empty row. A loop raises the degree of its node, scaling down its other
+// entries, and lowers the diagonal entry below one.
+func (graph *Graph) NormalizedLaplacianMatrix(weightAttr string) (order []string, matrix [][]float64, err error) {
+	var adj *adjacency
+	if adj, err = newUndirectedAdjacency(graph, weightAttr); err == nil {
+		order = adj.ids
+		matrix = adj.laplacian(true)
+	}
+	return
+}
+
+// AlgebraicConnectivity is the second smallest eigenvalue of the Laplacian of an
+// undirected graph, or of its normalized Laplacian when normalized is set.
+func (graph *Graph) AlgebraicConnectivity(weightAttr string, normalized bool) (connectivity float64, err error) {
+	var values []float64
+	if values, _, _, err = graph.fiedler(weightAttr, normalized); err == nil {
+		connectivity = values[1]
+	}
+	return
+}
+
+// FiedlerVector is the eigenvector of the second smallest eigenvalue of the
+// Laplacian of an undirected graph, keyed by node id. The sign is chosen so the
+// first non zero entry, in node order, is positive.
+func (graph *Graph) FiedlerVector(weightAttr string, normalized bool) (vector map[string]float64, err error) {
+	var order []string
+	var vectors [][]float64
+	if _, vectors, order, err = graph.fiedler(weightAttr, normalized); err == nil {
+		vector = make(map[string]float64, len(order))
+		for i, id := range order {
+			vector[id] = vectors[1][i]
+		}
+	}
+	return
+}
+
+// SpectralBisection splits an undirected graph in two by the sign of its
+// Fiedler vector. Nodes with a non negative entry go to the first set. Both sets
+// are in node order.
+func (graph *Graph) SpectralBisection(weightAttr string, normalized bool) (first []string, second []string, err error) {
+	var order []string
+	var vectors [][]float64
+	if _, vectors, order, err = graph.fiedler(weightAttr, normalized); err == nil {
+		for i, id := range order {
+			if vectors[1][i] >= 0 {
+				first = append(first, id)
+			} else {
+				second = append(second, id)
+			}
+		}
+	}
+	return
+}
+
+// fiedler will solve the two smallest eigenpairs of the Laplacian.
+func (graph *Graph) fiedler(weightAttr string, normalized bool) (values []float64, vectors [][]float64, order []string, err error) {
+	var adj *adjacency
+	if adj, err = newUndirectedAdjacency(graph, weightAttr); err != nil {
+		return
+	}
+
+	if adj.size() < 2 {
+		err = ErrTooFewNodes
+		return
+	}
+
+	order = adj.ids
+	rows := adj.sparseLaplacian(normalized)
+	values, vectors, err = smallestEigenpairs(adj.size(), 2, bound(rows), func(x []float64) []float64 {
+		return sparseMultiply(rows, x)
+	})
+	return
+}
+
+// SmallestEigenpairs computes the k smallest eigenvalues, in ascending order,
+// and their unit eigenvectors of a symmetric matrix. Every eigenpair is found by
+// a Lanczos iteration with full reorthogonalization, started orthogonal to the
+// eigenvectors already found, until the residual of its smallest Ritz pair is
+// small enough. The sign of every vector is chosen so its first non zero entry
+// is positive.
+func SmallestEigenpairs(matrix [][]float64, k int) (values []float64, vectors [][]float64, err error) {
+	n := len(matrix)
+	if k > n {
+		err = ErrTooManyEigenpairs
+		return
+	}
+
+	rows := make([][]entry, n)
+	for i, row := range matrix {
+		if len(row) != n {
+			err = ErrNotSquare
+			return
+		}
+
+		for j, value := range row {
+			if value != 0 {
+				rows[i] = append(rows[i], entry{column: j, value: value})
+			}
+		}
+	}
+
+	return smallestEigenpairs(n, k, bound(rows), func(x []float64) []float64 {
+		return sparseMultiply(rows, x)
+	})
+}
+
+// smallestEigenpairs will solve the k smallest eigenpairs of the symmetric
+// operator of dimension n, its spectrum bounded in absolute value by limit.
+func smallestEigenpairs(n int, k int, limit float64, apply func(x []float64) []float64) (values []float64, vectors [][]float64, err error) {
+	random := rand.New(rand.NewSource(1))
+	tolerance := spectralTolerance * math.Max(limit, 1)
+
+	for len(vectors) < k {
+		var x []float64
+		if x, err = lanczos(n, apply, vectors, tolerance, random); err != nil {
+			return
+		}
+
+		value := dot(x, apply(x))
+		if !(l2Distance(apply(x), scale(x, value)) <= math.Sqrt(tolerance)) {
+			err = ErrNotConverged
+			return
+		}
+
+		for _, component := range x {
+			if math.Abs(component) > spectralTolerance {
+				if component < 0 {
+					x = scale(x, -1)
+				}
+				break
+			}
+		}
+
+		values = append(values, value)
+		vectors = append(vectors, x)
+	}
+
+	return
+}
+
+// lanczos will find the eigenvector of the smallest eigenvalue of the operator
+// on the space orthogonal to the found vectors. It builds an orthonormal basis
+// of the Krylov space from a random start, and stops once the residual of the
+// smallest Ritz pair, or the next basis vector, is below the tolerance.
+func lanczos(n int, apply func(x []float64) []float64, found [][]float64, tolerance float64, random *rand.Rand) (x []float64, err error) {
+	q := make([]float64, n)
+	for i := range q {
+		q[i] = random.Float64() - 0.5
+	}
+	deflate(q, found)
+	if normalizeL2(q) == 0 {
+		err = ErrNotConverged
+		return
+	}
+
+	basis := [][]float64{q}
+	var alpha, beta []float64
+	for j := 0; ; j++ {
+		w := apply(basis[j])
+		alpha = append(alpha, dot(w, basis[j]))
+
+		// orthogonalizing again, when most of w cancelled, keeps the basis
+		// orthonormal in floating point.
+		for pass, length := 0, math.Sqrt(dot(w, w)); pass < 2; pass++ {
+			deflate(w, found)
+			deflate(w, basis)
+			if norm := math.Sqrt(dot(w, w)); norm > length/math.Sqrt2 {
+				break
+			} else {
+				length = norm
+			}
+		}
+		norm := normalizeL2(w)
+
+		s := smallestRitzVector(alpha, beta)
+		if norm*math.Abs(s[j]) < tolerance || norm < tolerance || len(basis)+len(found) == n {
+			x = make([]float64, n)
+			for i, v := range basis {
+				for l := range x {
+					x[l] += s[i] * v[l]
+				}
+			}
+			normalizeL2(x)
+			return
+		}
+
+		beta = append(beta, norm)
+		basis = append(basis, w)
+	}
+}
+
+// smallestRitzVector will find the unit eigenvector of the smallest eigenvalue
+// of the symmetric tridiagonal matrix with the diagonal alpha and the off
+// diagonal beta. The eigenvalue is located by bisection on the Sturm sequence
+// and the eigenvector by inverse iteration just below it.
+func smallestRitzVector(alpha []float64, beta []float64) (s []float64) {
+	lower, upper := math.Inf(1), math.Inf(-1)
+	for i := range alpha {
+		radius := 0.0
+		if i > 0 {
+			radius += math.Abs(beta[i-1])
+		}
+		if i < len(beta) {
+			radius += math.Abs(beta[i])
+		}
+		lower = math.Min(lower, alpha[i]-radius)
+		upper = math.Max(upper, alpha[i]+radius)
+	}
+
+	width := math.Max(upper-lower, math.Max(math.Abs(lower), math.Abs(upper)))
+	if width == 0 {
+		width = 1
+	}
+	for upper-lower > width*1.0e-15 {
+		if mid := (lower + upper) / 2; sturmCount(alpha, beta, mid) > 0 {
+			upper = mid
+		} else {
+			lower = mid
+		}
+	}
+
+	shift := lower - width*1.0e-12
+	s = make([]float64, len(alpha))
+	for i := range s {
+		s[i] = 1
+	}
+	for iteration := 0; iteration < 3; iteration++ {
+		s = solveTridiagonal(alpha, beta, shift, s)
+		normalizeL2(s)
+	}
+
+	return
+}
+
+// sturmCount will count the eigenvalues below x of the symmetric tridiagonal
+// matrix, the negative pivots in the elimination of the matrix shifted by x.
+func sturmCount(alpha []float64, beta []float64, x float64) (count int) {
+	pivot := 1.0
+	for i := range alpha {
+		if i == 0 {
+			pivot = alpha[i] - x
+		} else {
+			pivot = alpha[i] - x - beta[i-1]*beta[i-1]/pivot
+		}
+
+		if pivot == 0 {
+			pivot = -math.SmallestNonzeroFloat64
+		}
+		if pivot < 0 {
+			count++
+		}
+	}
+	return
+}
+
+// solveTridiagonal will solve the symmetric tridiagonal system, the diagonal
+// alpha shifted down by shift, by elimination without pivoting. The shifted
+// matrix is positive definite when the shift is below its spectrum.
+func solveTridiagonal(alpha []float64, beta []float64, shift float64, b []float64) (x []float64) {
+	n := len(alpha)
+	upper := make([]float64, n)
+	x = make([]float64, n)
+
+	for i := 0; i < n; i++ {
+		pivot := alpha[i] - shift
+		x[i] = b[i]
+		if i > 0 {
+			pivot -= beta[i-1] * upper[i-1]
+			x[i] -= beta[i-1] * x[i-1]
+		}
+
+		if pivot == 0 {
+			pivot = math.SmallestNonzeroFloat64
+		}
+		if i < n-1 {
+			upper[i] = beta[i] / pivot
+		}
+		x[i] /= pivot
+	}
+
+	for i := n - 2; i >= 0; i-- {
+		x[i] -= upper[i] * x[i+1]
+	}
+
+	return
+}
+
+// newUndirectedAdjacency will take a snapshot of an undirected graph.
+func newUndirectedAdjacency(graph *Graph, weightAttr string) (adj *adjacency, err error) {
+	if adj, err = newAdjacency(graph, weightAttr); err == nil && adj.directed {
+		adj, err = nil, &GraphTypeError{Type: graph.Type, Err: ErrNotUndirected}
+	}
+	return
+}
+
+// adjacencyMatrix will build the dense weighted adjacency matrix.
+func (adj *adjacency) adjacencyMatrix() (matrix [][]float64) {
+	matrix = newMatrix(adj.size())
+	for u, arcs := range adj.out {
+		for _, arc := range arcs {
+			matrix[u][arc.to] += adj.matrixWeight(u, arc)
+		}
+	}
+	return
+}
+
+// laplacian will build the plain or the symmetric normalized Laplacian.
+func (adj *adjacency) laplacian(normalized bool) (matrix [][]float64) {
+	matrix = newMatrix(adj.size())
+	for u, row := range adj.sparseLaplacian(normalized) {
+		for _, e := range row {
+			matrix[u][e.column] = e.value
+		}
+	}
+	return
+}
+
+// entry is a non zero value of a sparse matrix row.
+type entry struct {
+	column int
+	value  float64
+}
+
+// sparseLaplacian will build the rows of the plain or the symmetric normalized
+// Laplacian, the entries in column order.
+func (adj *adjacency) sparseLaplacian(normalized bool) (rows [][]entry) {
+	degree := make([]float64, adj.size())
+	for u, arcs := range adj.out {
+		for _, arc := range arcs {
+			degree[u] += adj.matrixWeight(u, arc)
+		}
+	}
+
+	rows = make([][]entry, adj.size())
+	for u, arcs := range adj.out {
+		weights := map[int]float64{}
+		for _, arc := range arcs {
+			weights[arc.to] += adj.matrixWeight(u, arc)
+		}
+
+		diagonal := 0.0
+		for v, weight := range weights {
+			if weight == 0 {
+				continue
+			} else if normalized {
+				weight = -weight / math.Sqrt(degree[u]*degree[v])
+			} else {
+				weight = -weight
+			}
+
+			if v == u {
+				diagonal = weight
+			} else {
+				rows[u] = append(rows[u], entry{column: v, value: weight})
+			}
+		}
+
+		if !normalized {
+			diagonal += degree[u]
+		} else if degree[u] != 0 {
+			diagonal++
+		}
+		if diagonal != 0 {
+			rows[u] = append(rows[u], entry{column: u, value: diagonal})
+		}
+
+		sort.Slice(rows[u], func(i, j int) bool {
+			return rows[u][i].column < rows[u][j].column
+		})
+	}
+
+	return
+}
+
+// bound is the Gershgorin bound on the absolute values of the eigenvalues of
+// the sparse matrix, its largest absolute row sum.
+func bound(rows [][]entry) (limit float64) {
+	for _, row := range rows {
+		sum := 0.0
+		for _, e := range row {
+			sum += math.Abs(e.value)
+		}
+		limit = math.Max(limit, sum)
+	}
+	return
+}
+
+// sparseMultiply will compute the product of the sparse matrix and the vector.
+func sparseMultiply(rows [][]entry, x []float64) (y []float64) {
+	y = make([]float64, len(rows))
+	for i, row := range rows {
+		for _, e := range row {
+			y[i] += e.value * x[e.column]
+		}
+	}
+	return
+}
+
+// newMatrix will allocate a square matrix of zeros.
+func newMatrix(n int) (matrix [][]float64) {
+	matrix = make([][]float64, n)
+	for i := range matrix {
+		matrix[i] = make([]float64, n)
+	}
+	return
+}
+
+// deflate will remove the components along the orthonormal vectors.
+func deflate(x []float64, vectors [][]float64) {
+	for _, v := range vectors {
+		dot := 0.0
+		for i := range x {
+			dot += x[i] * v[i]
+		}
+		for i := range x {
+			x[i] -= dot * v[i]
+		}
+	}
+}
+
+// scale will multiply the vector by the factor into a new vector.
+func scale(x []float64, factor float64) (y []float64) {
+	y = make([]float64, len(x))
+	for i, value := range x {
+		y[i] = value * factor
+	}
+	return
+}
+
+// dot is the inner product of the vectors.
+func dot(a []float64, b []float64) (value float64) {
+	for i := range a {
+		value += a[i] * b[i]
+	}
+	return
+}
+
+// l2Distance is the euclidean distance between the vectors.
+func l2Distance(a []float64, b []float64) (distance float64) {
+	for i := range a {
+		distance += (a[i] - b[i]) * (a[i] - b[i])
+	}
+	return math.Sqrt(distance)
+}
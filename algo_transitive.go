@@ -0,0 +1,244 @@
+package graph
+
+// Reachability is a bitset index answering which nodes of a directed graph
+// reach which others.
+type Reachability struct {
+
+	// index of every node id.
+	index map[string]int
+
+	// bits holds, for every node, the set of nodes it reaches.
+	bits [][]uint64
+}
+
+// Reaches reports if there is a path of at least one edge from the source to
+// the target. A node only reaches itself when it lies on a cycle.
+func (reach *Reachability) Reaches(source string, target string) bool {
+	u, okSource := reach.index[source]
+	v, okTarget := reach.index[target]
+	return okSource && okTarget && reach.bits[u][v/64]&(1<<uint(v%64)) != 0
+}
+
+// ReachabilityIndex builds the reachability index of a directed graph. The
+// strongly connected components are collapsed and their reachable sets merged
+// in reverse topological order, so every edge is only visited once.
+func (graph *Graph) ReachabilityIndex() (reach *Reachability, err error) {
+	var adj *adjacency
+	if adj, err = newDirectedAdjacency(graph); err == nil {
+		reach = &Reachability{index: adj.index, bits: adj.reachability()}
+	}
+	return
+}
+
+// TransitiveClosure creates the transitive closure of a directed graph: a new
+// graph with the same nodes and edges, plus an edge from every node to every
+// node it reaches that it was not linked to. The added edges have no attributes
+// and the default ids, followed by '#2', '#3', ... when an edge of the graph
+// already uses them. Self loops are not added.
+func (graph *Graph) TransitiveClosure() (closure *Graph, err error) {
+	var adj *adjacency
+	if adj, err = newDirectedAdjacency(graph); err != nil {
+		return
+	}
+
+	if closure, err = graph.Subgraph(adj.ids); err != nil {
+		return
+	}
+
+	bits := adj.reachability()
+	for u, id := range adj.ids {
+		linked := map[int]bool{u: true}
+		for _, arc := range adj.out[u] {
+			linked[arc.to] = true
+		}
+
+		for v, target := range adj.ids {
+			if !linked[v] && bits[u][v/64]&(1<<uint(v%64)) != 0 {
+				attrs := NewAttributeCollection()
+				attrs.Set("id", closure.freeEdgeID(id+"-"+target))
+				if _, err = closure.AddEdge(attrs, []string{id, target}); err != nil {
+					return
+				}
+			}
+		}
+	}
+
+	return
+}
+
+// TransitiveReduction creates the transitive reduction of a directed acyclic
+// graph: a new graph with the same nodes and the smallest set of the original
+// edges, with their ids and attributes, giving the same reachability. Of
+// parallel edges only the one with the smallest id is kept.
+func (graph *Graph) TransitiveReduction() (reduction *Graph, err error) {
+	var adj *adjacency
+	if adj, err = newDirectedAdjacency(graph); err != nil {
+		return
+	}
+
+	for _, component := range adj.stronglyConnected(nil) {
+		if len(component) > 1 {
+			err = ErrNotAcyclic
+			return
+		}
+	}
+
+	bits := adj.reachability()
+	reaches := func(u int, v int) bool {
+		return bits[u][v/64]&(1<<uint(v%64)) != 0
+	}
+
+	if reduction, err = NewGraph(graph.Type); err != nil {
+		return
+	}
+	reduction.Attributes = graph.Attributes.Copy()
+
+	for _, id := range adj.ids {
+		var node *Node
+		if node, err = reduction.AddNode(id); err != nil {
+			return
+		}
+		node.Attributes = graph.Nodes[id].Attributes.Copy()
+	}
+
+	kept := map[*Edge]bool{}
+	for u, arcs := range adj.out {
+		seen := map[int]bool{}
+		for _, a := range arcs {
+			if a.to == u {
+				err = ErrNotAcyclic
+				return
+			}
+
+			if seen[a.to] {
+				continue
+			}
+			seen[a.to] = true
+
+			// the arc is redundant when another successor reaches its target.
+			redundant := false
+			for _, b := range arcs {
+				if b.to != a.to && reaches(b.to, a.to) {
+					redundant = true
+					break
+				}
+			}
+
+			if !redundant {
+				kept[a.edge] = true
+			}
+		}
+	}
+
+	for _, edge := range sortedEdges(graph) {
+		if kept[edge] {
+			if _, err = reduction.copyEdge(edge); err != nil {
+				return
+			}
+		}
+	}
+
+	return
+}
+
+// newDirectedAdjacency will take a snapshot of a directed graph.
+func newDirectedAdjacency(graph *Graph) (adj *adjacency, err error) {
+	if adj, err = newAdjacency(graph, ""); err == nil && !adj.directed {
+		adj, err = nil, &GraphTypeError{Type: graph.Type, Err: ErrNotDirected}
+	}
+	return
+}
+
+// reachability will compute the set of nodes every node reaches.
+func (adj *adjacency) reachability() (bits [][]uint64) {
+	words := (adj.size() + 63) / 64
+	bits = make([][]uint64, adj.size())
+
+	// the components come out sinks first, so successors are always done.
+	for _, component := range adj.stronglyConnected(nil) {
+		set := make([]uint64, words)
+		members := map[int]bool{}
+		for _, u := range component {
+			members[u] = true
+		}
+
+		for _, u := range component {
+			for _, a := range adj.out[u] {
+				if members[a.to] {
+					// an arc inside the component puts all its members on a cycle.
+					for _, w := range component {
+						set[w/64] |= 1 << uint(w%64)
+					}
+				} else {
+					set[a.to/64] |= 1 << uint(a.to%64)
+					for i, word := range bits[a.to] {
+						set[i] |= word
+					}
+				}
+			}
+		}
+
+		for _, u := range component {
+			bits[u] = set
+		}
+	}
+
+	return
+}
+
+// stronglyConnected will find the strongly connected components with Tarjan's
+// algorithm, only among the allowed nodes when given. The components come out
+// in reverse topological order.
+func (adj *adjacency) stronglyConnected(allowed []bool) (components [][]int) {
+	n := adj.size()
+	index := filled(n, -1)
+	low := make([]int, n)
+	onStack := make([]bool, n)
+
+	var stack []int
+	counter := 0
+
+	var connect func(u int)
+	connect = func(u int) {
+		index[u] = counter
+		low[u] = counter
+		counter++
+		stack = append(stack, u)
+		onStack[u] = true
+
+		for _, a := range adj.out[u] {
+			if allowed != nil && !allowed[a.to] {
+				continue
+			} else if index[a.to] < 0 {
+				connect(a.to)
+				if low[a.to] < low[u] {
+					low[u] = low[a.to]
+				}
+			} else if onStack[a.to] && index[a.to] < low[u] {
+				low[u] = index[a.to]
+			}
+		}
+
+		if low[u] == index[u] {
+			var component []int
+			for {
+				w := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[w] = false
+				component = append(component, w)
+				if w == u {
+					break
+				}
+			}
+			components = append(components, component)
+		}
+	}
+
+	for u := 0; u < n; u++ {
+		if index[u] < 0 && (allowed == nil || allowed[u]) {
+			connect(u)
+		}
+	}
+
+	return
+}
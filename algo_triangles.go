@@ -0,0 +1,109 @@
+package graph
+
+// Triangles counts the triangles every node of an undirected graph is part of,
+// keyed by node id. Parallel edges are counted once and loops are ignored.
+func (graph *Graph) Triangles() (triangles map[string]int, err error) {
+	var adj *adjacency
+	if adj, err = newUndirectedAdjacency(graph, ""); err == nil {
+		triangles = make(map[string]int, adj.size())
+		for u, count := range adj.triangles() {
+			triangles[adj.ids[u]] = count
+		}
+	}
+	return
+}
+
+// Clustering computes the local clustering coefficient of every node of an
+// undirected graph, keyed by node id. Nodes with less than two neighbours have
+// a coefficient of 0.
+func (graph *Graph) Clustering() (clustering map[string]float64, err error) {
+	var adj *adjacency
+	if adj, err = newUndirectedAdjacency(graph, ""); err == nil {
+		clustering = make(map[string]float64, adj.size())
+		for u, count := range adj.triangles() {
+			clustering[adj.ids[u]] = 0
+			if degree := len(adj.nbrs[u]); degree > 1 {
+				clustering[adj.ids[u]] = 2 * float64(count) / float64(degree*(degree-1))
+			}
+		}
+	}
+	return
+}
+
+// AverageClustering is the mean of the local clustering coefficients of an
+// undirected graph.
+func (graph *Graph) AverageClustering() (average float64, err error) {
+	var clustering map[string]float64
+	if clustering, err = graph.Clustering(); err == nil && len(clustering) != 0 {
+		for _, coefficient := range clustering {
+			average += coefficient
+		}
+		average /= float64(len(clustering))
+	}
+	return
+}
+
+// Transitivity is the fraction of all the connected triples of an undirected
+// graph that are closed into triangles.
+func (graph *Graph) Transitivity() (transitivity float64, err error) {
+	var adj *adjacency
+	if adj, err = newUndirectedAdjacency(graph, ""); err == nil {
+		closed, triples := 0, 0
+		for u, count := range adj.triangles() {
+			degree := len(adj.nbrs[u])
+			closed += count
+			triples += degree * (degree - 1) / 2
+		}
+
+		if triples != 0 {
+			transitivity = float64(closed) / float64(triples)
+		}
+	}
+	return
+}
+
+// triangles will count the triangles at every node. Every neighbourhood is
+// oriented from the lower to the higher degree node, so a triangle is only
+// found once, from its lowest ranked corner, and high degree nodes are only
+// scanned through their few higher ranked neighbours.
+func (adj *adjacency) triangles() (counts []int) {
+	n := adj.size()
+	counts = make([]int, n)
+
+	higher := func(u int, v int) bool {
+		du, dv := len(adj.nbrs[u]), len(adj.nbrs[v])
+		return du < dv || (du == dv && u < v)
+	}
+
+	forward := make([][]int, n)
+	for u, nbrs := range adj.nbrs {
+		for _, v := range nbrs {
+			if higher(u, v) {
+				forward[u] = append(forward[u], v)
+			}
+		}
+	}
+
+	marked := make([]bool, n)
+	for u := range forward {
+		for _, v := range forward[u] {
+			marked[v] = true
+		}
+
+		for _, v := range forward[u] {
+			for _, w := range forward[v] {
+				if marked[w] {
+					counts[u]++
+					counts[v]++
+					counts[w]++
+				}
+			}
+		}
+
+		for _, v := range forward[u] {
+			marked[v] = false
+		}
+	}
+
+	return
+}
@@ -0,0 +1,147 @@
+package graph
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// defaultWLIterations is the number of refinements used by the WL hashes.
+const defaultWLIterations = 3
+
+// WLOptions tunes the Weisfeiler-Lehman hashes. A nil value will use the defaults.
+type WLOptions struct {
+
+	// NodeAttr is the node attribute used as the initial label. Empty labels
+	// every node with its degree.
+	NodeAttr string
+
+	// EdgeAttr is the edge attribute prefixed to the labels of the neighbours.
+	// Empty ignores the edge attributes.
+	EdgeAttr string
+
+	// Iterations of the label refinement. Zero uses the default of 3.
+	Iterations int
+}
+
+// WLHash computes the Weisfeiler-Lehman hash of the graph. Isomorphic graphs
+// always hash the same, and graphs hashing the same are isomorphic with high
+// probability. Directed graphs aggregate the labels of the successors.
+func (graph *Graph) WLHash(options *WLOptions) (hash string, err error) {
+	var labels [][]string
+	if _, labels, err = graph.wlLabels(options); err == nil {
+		var histogram []string
+		for _, iteration := range labels {
+			counts := map[string]int{}
+			for _, label := range iteration {
+				counts[label]++
+			}
+
+			var lines []string
+			for label, count := range counts {
+				lines = append(lines, label+":"+strconv.Itoa(count))
+			}
+			sort.Strings(lines)
+			histogram = append(histogram, strings.Join(lines, ","))
+		}
+
+		hash = wlDigest(strings.Join(histogram, ";"))
+	}
+	return
+}
+
+// WLSubtreeHashes computes the hash of the subtree rooted at every node after
+// every iteration, keyed by node id. Nodes with the same hash at an iteration
+// have indistinguishable neighbourhoods up to that depth, which also holds
+// between different graphs hashed with the same options.
+func (graph *Graph) WLSubtreeHashes(options *WLOptions) (hashes map[string][]string, err error) {
+	var ids []string
+	var labels [][]string
+	if ids, labels, err = graph.wlLabels(options); err == nil {
+		hashes = make(map[string][]string, len(ids))
+		for _, iteration := range labels {
+			for u, label := range iteration {
+				hashes[ids[u]] = append(hashes[ids[u]], label)
+			}
+		}
+	}
+	return
+}
+
+// wlLabels will refine the node labels, returning the labels of every
+// iteration in node order.
+func (graph *Graph) wlLabels(options *WLOptions) (ids []string, labels [][]string, err error) {
+	if options == nil {
+		options = &WLOptions{}
+	}
+
+	iterations := options.Iterations
+	if iterations <= 0 {
+		iterations = defaultWLIterations
+	}
+
+	var adj *adjacency
+	if adj, err = newAdjacency(graph, ""); err != nil {
+		return
+	}
+	ids = adj.ids
+
+	current := make([]string, adj.size())
+	for u, id := range adj.ids {
+		if len(options.NodeAttr) != 0 {
+			current[u] = attributeLabel(graph.Nodes[id].Attributes, options.NodeAttr)
+		} else {
+			current[u] = strconv.Itoa(adj.degree(u))
+		}
+	}
+
+	for i := 0; i < iterations; i++ {
+		next := make([]string, adj.size())
+		for u, arcs := range adj.out {
+			var neighbours []string
+			for _, arc := range arcs {
+				label := current[arc.to]
+				if len(options.EdgeAttr) != 0 {
+					label = wlJoin(attributeLabel(arc.edge.Attributes, options.EdgeAttr), label)
+				}
+				neighbours = append(neighbours, label)
+			}
+			sort.Strings(neighbours)
+
+			next[u] = wlDigest(wlJoin(current[u], wlJoin(neighbours...)))
+		}
+
+		labels = append(labels, next)
+		current = next
+	}
+
+	return
+}
+
+// attributeLabel will format the attribute as a label, prefixed by the type of
+// its value so 1 and "1" differ. A missing attribute has an empty label, which
+// no value has.
+func attributeLabel(attrs AttributeCollection, name string) (label string) {
+	if value, ok := attrs.Get(name); ok {
+		label = fmt.Sprintf("%T:%#v", value, value)
+	}
+	return
+}
+
+// wlJoin will join the labels, every one prefixed by its length, so different
+// lists of labels never join the same.
+func wlJoin(labels ...string) (joined string) {
+	for _, label := range labels {
+		joined += strconv.Itoa(len(label)) + ":" + label
+	}
+	return
+}
+
+// wlDigest will hash the text to 32 hex characters.
+func wlDigest(text string) string {
+	sum := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(sum[:16])
+}
@@ -15,6 +15,15 @@ func BuildAttributes(template map[string]interface{}) (attrs AttributeCollection
 	return
 }
 
+// Copy the attributes into a new collection. The values are not copied.
+func (attrs AttributeCollection) Copy() (result AttributeCollection) {
+	result = make(AttributeCollection, len(attrs))
+	for key, value := range attrs {
+		result[key] = value
+	}
+	return
+}
+
 // Count the attributes.
 func (attrs AttributeCollection) Count() int {
 	return len(attrs)
@@ -0,0 +1,72 @@
+package graph
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestEdgeBetweenness(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/ud_6n_7e.json"); err != nil {
+		t.Error(err)
+	} else {
+		betweenness, err := graph.EdgeBetweenness("", false)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		AssertT(t, "Bridge", 9.0, betweenness["c-d"])
+		AssertT(t, "Triangle edge", 1.0, betweenness["a-b"])
+		AssertT(t, "Spoke edge", 4.0, betweenness["a-c"])
+
+		if betweenness, err = graph.EdgeBetweenness("", true); err == nil {
+			AssertT(t, "Normalized bridge", 0.6, betweenness["c-d"])
+		} else {
+			t.Error(err)
+		}
+	}
+}
+
+func TestEdgeBetweennessWeighted(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/ud_6n_7e.json"); err != nil {
+		t.Error(err)
+	} else {
+		graph.Edges["a-c"].Attributes.Set("weight", 5)
+
+		betweenness, err := graph.EdgeBetweenness("weight", false)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		AssertT(t, "Heavy edge", 0.0, betweenness["a-c"])
+		AssertT(t, "Detour edge", 5.0, betweenness["a-b"])
+
+		graph.Edges["a-c"].Attributes.Set("weight", -1)
+		if _, err = graph.EdgeBetweenness("weight", false); !errors.Is(err, ErrNegativeWeight) {
+			printError(t, "Negative weight", ErrNegativeWeight, err)
+		}
+		if _, err = graph.GirvanNewman("weight"); !errors.Is(err, ErrNegativeWeight) {
+			printError(t, "Negative weight dendrogram", ErrNegativeWeight, err)
+		}
+	}
+}
+
+func TestGirvanNewman(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/ud_6n_7e.json"); err != nil {
+		t.Error(err)
+	} else {
+		levels, err := graph.GirvanNewman("")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		AssertT(t, "Levels", true, len(levels) > 2)
+		AssertT(t, "Root", 1, len(levels[0].Components))
+		AssertWithCheckFunc(t, "First split", []string{"c-d"}, levels[1].Removed, reflect.DeepEqual)
+		AssertWithCheckFunc(t, "First components", [][]string{{"a", "b", "c"}, {"d", "e", "f"}}, levels[1].Components, reflect.DeepEqual)
+		AssertT(t, "Leaves", 6, len(levels[len(levels)-1].Components))
+	}
+}
@@ -0,0 +1,109 @@
+package graph
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func maxScore(scores map[string]float64) (best string) {
+	for id, score := range scores {
+		if len(best) == 0 || score > scores[best] || (score == scores[best] && id < best) {
+			best = id
+		}
+	}
+	return
+}
+
+func TestHITS(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/d_4n_5e.json"); err != nil {
+		t.Error(err)
+	} else {
+		hubs, authorities, report, err := graph.HITS(nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		AssertT(t, "HITS converged", true, report.Converged)
+		AssertT(t, "HITS best hub", "a", maxScore(hubs))
+		AssertT(t, "HITS best authority", "c", maxScore(authorities))
+		AssertT(t, "HITS authority of d", 0.0, authorities["d"])
+
+		sum := 0.0
+		for _, score := range hubs {
+			sum += score
+		}
+		AssertT(t, "HITS hub sum", true, math.Abs(sum-1) < 1e-9)
+	}
+}
+
+func TestEigenvectorCentrality(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/ud_5n_7e.json"); err != nil {
+		t.Error(err)
+	} else {
+		centrality, report, err := graph.EigenvectorCentrality(nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		AssertT(t, "Eigenvector converged", true, report.Converged)
+		AssertT(t, "Eigenvector best", "2", maxScore(centrality))
+		AssertT(t, "Eigenvector symmetry", true, math.Abs(centrality["1"]-centrality["3"]) < 1e-6)
+
+		norm := 0.0
+		for _, score := range centrality {
+			norm += score * score
+		}
+		AssertT(t, "Eigenvector norm", true, math.Abs(norm-1) < 1e-9)
+	}
+}
+
+func TestEigenvectorCentralityWeighted(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/ud_5n_7e.json"); err != nil {
+		t.Error(err)
+	} else {
+		graph.Edges["b"].Attributes.Set("weight", 10)
+
+		centrality, _, err := graph.EigenvectorCentrality(&IterationOptions{WeightAttr: "weight"})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		AssertT(t, "Weighted eigenvector best", true, centrality["1"] > centrality["3"])
+	}
+}
+
+func TestKatzCentrality(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/d_4n_5e.json"); err != nil {
+		t.Error(err)
+	} else {
+		centrality, report, err := graph.KatzCentrality(0.1, 1, nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		AssertT(t, "Katz converged", true, report.Converged)
+		AssertT(t, "Katz best", "c", maxScore(centrality))
+		AssertT(t, "Katz source only", true, centrality["d"] < centrality["b"])
+	}
+}
+
+func TestCentralityBadWeight(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/ud_5n_7e.json"); err != nil {
+		t.Error(err)
+	} else {
+		graph.Edges["a"].Attributes.Set("weight", "heavy")
+
+		var edgeErr *EdgeError
+		if _, _, err := graph.EigenvectorCentrality(&IterationOptions{WeightAttr: "weight"}); err != nil {
+			AssertT(t, "Bad weight", true, errors.Is(err, ErrNonNumericWeight))
+			AssertT(t, "Bad weight edge", true, errors.As(err, &edgeErr) && edgeErr.ID == "a")
+		} else {
+			t.Error("Expected an error, non numeric weight.")
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package graph
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestMaximalCliques(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/ud_5n_7e.json"); err != nil {
+		t.Error(err)
+	} else {
+		var cliques []string
+		if err = graph.MaximalCliques(func(clique []string) (cont bool, err error) {
+			cliques = append(cliques, strings.Join(clique, ","))
+			return true, nil
+		}); err != nil {
+			t.Error(err)
+			return
+		}
+
+		sort.Strings(cliques)
+		AssertWithCheckFunc(t, "Cliques", []string{"1,2,5", "2,3,4", "2,4,5"}, cliques, reflect.DeepEqual)
+	}
+}
+
+func TestMaximalCliquesEarlyStop(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/ud_5n_7e.json"); err != nil {
+		t.Error(err)
+	} else {
+		count := 0
+		if err = graph.MaximalCliques(func(clique []string) (cont bool, err error) {
+			count++
+			return false, nil
+		}); err != nil {
+			t.Error(err)
+		}
+
+		AssertT(t, "Early stop", 1, count)
+
+		if err = graph.MaximalCliques(nil); err == nil {
+			t.Error("Expected an error, no iteration function.")
+		}
+	}
+}
+
+func TestMaximumClique(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/ud_8n_9e.json"); err != nil {
+		t.Error(err)
+	} else if clique, err := graph.MaximumClique(); err == nil {
+		AssertWithCheckFunc(t, "Maximum clique", []string{"t", "w", "x"}, clique, reflect.DeepEqual)
+	} else {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,96 @@
+package graph
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func assertTwoTriangles(t *testing.T, testName string, communities map[string]int, modularity float64) {
+	AssertT(t, testName+"[a-b]", communities["a"], communities["b"])
+	AssertT(t, testName+"[a-c]", communities["a"], communities["c"])
+	AssertT(t, testName+"[d-e]", communities["d"], communities["e"])
+	AssertT(t, testName+"[d-f]", communities["d"], communities["f"])
+	AssertT(t, testName+"[first]", 0, communities["a"])
+	AssertT(t, testName+"[second]", 1, communities["d"])
+	AssertT(t, testName+"[modularity]", true, math.Abs(modularity-(6.0/7.0-0.5)) < 1e-9)
+}
+
+func TestLouvain(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/ud_6n_7e.json"); err != nil {
+		t.Error(err)
+	} else {
+		for seed := int64(0); seed < 5; seed++ {
+			communities, modularity, err := graph.Louvain("", rand.NewSource(seed))
+			if err != nil {
+				t.Error(err)
+				return
+			}
+
+			assertTwoTriangles(t, "Louvain", communities, modularity)
+		}
+	}
+}
+
+func TestLabelPropagation(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/ud_6n_7e.json"); err != nil {
+		t.Error(err)
+	} else {
+		first, _, err := graph.LabelPropagation("", rand.NewSource(42))
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		second, modularity, _ := graph.LabelPropagation("", rand.NewSource(42))
+		for id, c := range first {
+			AssertT(t, "Label propagation reproducible["+id+"]", c, second[id])
+		}
+
+		if actual, err := graph.Modularity(second, ""); err == nil {
+			AssertT(t, "Label propagation modularity", modularity, actual)
+		} else {
+			t.Error(err)
+		}
+	}
+}
+
+func TestCommunityGraph(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/ud_6n_7e.json"); err != nil {
+		t.Error(err)
+	} else {
+		communities, _, _ := graph.Louvain("", nil)
+
+		if err = graph.SetCommunityAttribute("community", communities); err != nil {
+			t.Error(err)
+		}
+		AssertNodeAttributeValue(t, graph, "f", "community", 1)
+
+		quotient, err := graph.CommunityGraph(communities, "")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		AssertT(t, "Quotient nodes", 2, len(quotient.Nodes))
+		AssertT(t, "Quotient edges", 1, len(quotient.Edges))
+		AssertNodeAttributeValue(t, quotient, "0", "size", 3)
+		AssertNodeAttributeValue(t, quotient, "0", "weight", 3.0)
+
+		if edge, ok := quotient.Edges["0-1"]; ok {
+			weight, _ := edge.Attributes.Get("weight")
+			AssertT(t, "Quotient bridge", 1.0, weight)
+		} else {
+			t.Error("Expected the communities to be linked.")
+		}
+	}
+}
+
+func TestCommunityDirected(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/d_4n_5e.json"); err != nil {
+		t.Error(err)
+	} else if _, _, err = graph.Louvain("", nil); !errors.Is(err, ErrNotUndirected) {
+		t.Error("Expected an error, directed graph.")
+	}
+}
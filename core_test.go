@@ -0,0 +1,80 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestCoreNumbers(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/ud_8n_9e.json"); err != nil {
+		t.Error(err)
+	} else {
+		cores, err := graph.CoreNumbers()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		expected := map[string]int{"r": 1, "s": 1, "t": 2, "u": 2, "v": 1, "w": 2, "x": 2, "y": 2}
+		for id, k := range expected {
+			AssertT(t, "Core["+id+"]", k, cores[id])
+		}
+	}
+}
+
+func TestDegeneracyOrdering(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/ud_5n_7e.json"); err != nil {
+		t.Error(err)
+	} else {
+		order, degeneracy, err := graph.DegeneracyOrdering()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		AssertT(t, "Degeneracy", 2, degeneracy)
+		AssertT(t, "Order length", 5, len(order))
+
+		position := map[string]int{}
+		for i, id := range order {
+			position[id] = i
+		}
+
+		for _, id := range order {
+			later := 0
+			for _, n := range graph.Nodes[id].Adj() {
+				if position[n.ID] > position[id] {
+					later++
+				}
+			}
+			AssertT(t, "Later neighbours["+id+"]", true, later <= degeneracy)
+		}
+	}
+}
+
+func TestKCore(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/ud_8n_9e.json"); err != nil {
+		t.Error(err)
+	} else {
+		graph.Nodes["t"].Attributes.Set("color", "green")
+
+		core, err := graph.KCore(2)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		AssertT(t, "Core nodes", 5, len(core.Nodes))
+		AssertT(t, "Core edges", 6, len(core.Edges))
+		AssertNodeAttributeValue(t, core, "t", "color", "green")
+		AssertAreConnected(t, core, "t", "u", true)
+		AssertAreConnected(t, core, "w", "y", false)
+
+		if _, ok := core.Nodes["s"]; ok {
+			t.Error("Expected the periphery to be stripped.")
+		}
+
+		if _, ok := core.Edges["w-x"]; !ok {
+			t.Error("Expected the edge id to be kept.")
+		}
+	}
+}
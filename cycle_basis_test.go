@@ -0,0 +1,78 @@
+package graph
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func assertCycleClosed(t *testing.T, graph *Graph, cycle []string) {
+	// consecutive edges must share a node, including the last and the first.
+	for i, id := range cycle {
+		next := graph.Edges[cycle[(i+1)%len(cycle)]]
+		shared := false
+		for nid := range graph.Edges[id].Nodes {
+			_, ok := next.Nodes[nid]
+			shared = shared || ok
+		}
+		AssertT(t, "Cycle closed["+strings.Join(cycle, ",")+"]", true, shared)
+	}
+}
+
+func TestCycleBasis(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/ud_5n_7e.json"); err != nil {
+		t.Error(err)
+	} else {
+		basis, err := graph.CycleBasis()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		AssertT(t, "Basis size", 3, len(basis))
+		for _, cycle := range basis {
+			assertCycleClosed(t, graph, cycle)
+		}
+	}
+}
+
+func TestMinimumCycleBasis(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/ud_5n_7e.json"); err != nil {
+		t.Error(err)
+	} else {
+		basis, err := graph.MinimumCycleBasis("")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		var cycles []string
+		for _, cycle := range basis {
+			assertCycleClosed(t, graph, cycle)
+			sorted := append([]string{}, cycle...)
+			sort.Strings(sorted)
+			cycles = append(cycles, strings.Join(sorted, ""))
+		}
+		sort.Strings(cycles)
+
+		AssertWithCheckFunc(t, "Triangles", []string{"abe", "cdf", "deg"}, cycles, reflect.DeepEqual)
+
+		// a heavy spoke moves the basis to the square around it.
+		graph.Edges["e"].Attributes.Set("weight", 10)
+		if basis, err = graph.MinimumCycleBasis("weight"); err == nil {
+			cycles = nil
+			for _, cycle := range basis {
+				sorted := append([]string{}, cycle...)
+				sort.Strings(sorted)
+				cycles = append(cycles, strings.Join(sorted, ""))
+			}
+
+			AssertT(t, "Square basis size", 3, len(cycles))
+			AssertT(t, "Light triangle", "cdf", cycles[0])
+			AssertT(t, "Square", "abdg", cycles[1])
+		} else {
+			t.Error(err)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package graph
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestDirectedHypergraphLoading(t *testing.T) {
+	graph := LoadTestGraph(t, "./data/dh_6n_4e.json")
+
+	AssertT(t, "Type", GraphDirectedHyper, graph.Type)
+	AssertT(t, "Edges", 4, len(graph.Edges))
+
+	edge := graph.Edges["r1"]
+	AssertT(t, "Tail", true, reflect.DeepEqual([]string{"A", "B"}, idsOf(edge.Tail)))
+	AssertT(t, "Head", true, reflect.DeepEqual([]string{"C"}, idsOf(edge.Head)))
+	AssertT(t, "Nodes", 3, len(edge.Nodes))
+
+	rate, _ := edge.Attributes.Get("rate")
+	AssertT(t, "Attribute", 2.0, rate)
+
+	if _, err := LoadFileGraph("./data/e_hyperedgesets.json"); err == nil {
+		t.Error("Expected an error, three node sets.")
+	} else {
+		var edgeErr *EdgeError
+		AssertT(t, "Error", true, errors.Is(err, ErrHyperedgeSets) && errors.As(err, &edgeErr))
+		AssertT(t, "Error id", "r1", edgeErr.ID)
+	}
+
+	if _, err := LoadFileGraph("./data/e_hyperedgenodeid.json"); err == nil {
+		t.Error("Expected an error, a node id that is not a string.")
+	} else {
+		var edgeErr *EdgeError
+		AssertT(t, "Node id error", true, errors.Is(err, ErrInvalidNodeID) && errors.As(err, &edgeErr))
+		AssertT(t, "Node id error id", "r1", edgeErr.ID)
+	}
+}
+
+func TestDirectedConnection(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/d_4n_5e.json"); err != nil {
+		t.Error(err)
+	} else {
+		AssertAreConnected(t, graph, "a", "b", true)
+		AssertAreConnected(t, graph, "b", "a", false)
+		AssertAreConnected(t, graph, "c", "a", true)
+		AssertAreConnected(t, graph, "d", "b", false)
+	}
+
+	if graph, err := LoadFileGraph("./data/dh_6n_4e.json"); err != nil {
+		t.Error(err)
+	} else {
+		AssertAreConnected(t, graph, "A", "C", true)
+		AssertAreConnected(t, graph, "B", "C", true)
+		AssertAreConnected(t, graph, "C", "A", false)
+		AssertAreConnected(t, graph, "C", "E", true)
+		AssertAreConnected(t, graph, "D", "E", false)
+		AssertAreConnected(t, graph, "A", "B", false)
+	}
+}
+
+func TestAddHyperedge(t *testing.T) {
+	graph := buildGraph(t, GraphDirectedHyper, []string{"a", "b", "c"}, nil)
+
+	edge, err := graph.AddHyperedge(NewAttributeCollection(), []string{"a", "b", "a"}, []string{"c"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	AssertT(t, "Default id", "a+b->c", edge.ID)
+	AssertT(t, "Distinct tail", 2, len(edge.Tail))
+	AssertT(t, "Stored", edge, graph.Edges["a+b->c"])
+
+	if _, err = graph.AddHyperedge(NewAttributeCollection(), []string{"a"}, nil); !errors.Is(err, ErrMissingTailOrHead) {
+		t.Error("Expected an error for an empty head")
+	}
+
+	undirected := buildGraph(t, GraphUndirected, []string{"a", "b"}, nil)
+	if _, err = undirected.AddHyperedge(NewAttributeCollection(), []string{"a"}, []string{"b"}); !errors.Is(err, ErrNotDirectedHypergraph) {
+		t.Error("Expected an error for an undirected graph")
+	}
+
+	copied, err := graph.Subgraph([]string{"a", "b", "c"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertT(t, "Copied head", 1, len(copied.Edges["a+b->c"].Head))
+}
+
+func TestHyperReachable(t *testing.T) {
+	graph := LoadTestGraph(t, "./data/dh_6n_4e.json")
+
+	nodes, edges, err := graph.HyperReachable([]string{"A"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertT(t, "Nodes", true, reflect.DeepEqual([]string{"A", "C", "D", "E", "B"}, nodes))
+	AssertT(t, "Edges", true, reflect.DeepEqual([]string{"r1", "r2", "r4", "r3"}, edges))
+
+	if _, _, err = graph.HyperReachable([]string{"Z"}); err == nil {
+		t.Error("Expected an error for an unknown node")
+	}
+
+	undirected := buildGraph(t, GraphUndirected, []string{"a", "b"}, nil)
+	if _, _, err = undirected.HyperReachable([]string{"a"}); !errors.Is(err, ErrNotDirected) {
+		t.Error("Expected an error for an undirected graph")
+	}
+}
+
+func TestBConnected(t *testing.T) {
+	graph := LoadTestGraph(t, "./data/dh_6n_4e.json")
+
+	for _, test := range []struct {
+		sources []string
+		nodes   []string
+		edges   []string
+	}{
+		{[]string{"A"}, []string{"A"}, nil},
+		{[]string{"A", "B"}, []string{"A", "B", "C", "D", "E"}, []string{"r1", "r2", "r4"}},
+		{[]string{"C", "F"}, []string{"C", "F", "D", "E", "B", "A"}, []string{"r2", "r4", "r3", "r1"}},
+	} {
+		nodes, edges, err := graph.BConnected(test.sources)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(test.nodes, nodes) || !reflect.DeepEqual(test.edges, edges) {
+			t.Errorf("From %v expected %v through %v, got %v through %v", test.sources, test.nodes, test.edges, nodes, edges)
+		}
+	}
+}
+
+func TestDirectedHypergraphAlgorithms(t *testing.T) {
+	graph := LoadTestGraph(t, "./data/dh_6n_4e.json")
+
+	reach, err := graph.ReachabilityIndex()
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertT(t, "B reaches D", true, reach.Reaches("B", "D"))
+	AssertT(t, "F reaches A", true, reach.Reaches("F", "A"))
+	AssertT(t, "A reaches F", false, reach.Reaches("A", "F"))
+
+	_, _, matrix, err := graph.IncidenceMatrix()
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertT(t, "Incidence of r1", true, reflect.DeepEqual([]float64{-1, -1, 1, 0, 0, 0}, []float64{
+		matrix[0][0], matrix[1][0], matrix[2][0], matrix[3][0], matrix[4][0], matrix[5][0],
+	}))
+}
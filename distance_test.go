@@ -0,0 +1,130 @@
+package graph
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestEccentricity(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/ud_8n_9e.json"); err != nil {
+		t.Error(err)
+	} else {
+		eccentricity, err := graph.Eccentricity("")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		expected := map[string]float64{"r": 4, "s": 3, "t": 4, "u": 5, "v": 5, "w": 3, "x": 4, "y": 5}
+		AssertWithCheckFunc(t, "Eccentricity", expected, eccentricity, reflect.DeepEqual)
+
+		if diameter, err := graph.Diameter(""); err == nil {
+			AssertT(t, "Diameter", 5.0, diameter)
+		} else {
+			t.Error(err)
+		}
+
+		if radius, err := graph.Radius(""); err == nil {
+			AssertT(t, "Radius", 3.0, radius)
+		} else {
+			t.Error(err)
+		}
+
+		if center, err := graph.Center(""); err == nil {
+			AssertWithCheckFunc(t, "Center", []string{"s", "w"}, center, reflect.DeepEqual)
+		} else {
+			t.Error(err)
+		}
+
+		if periphery, err := graph.Periphery(""); err == nil {
+			AssertWithCheckFunc(t, "Periphery", []string{"u", "v", "y"}, periphery, reflect.DeepEqual)
+		} else {
+			t.Error(err)
+		}
+	}
+}
+
+func TestEccentricityWeighted(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/ud_8n_9e.json"); err != nil {
+		t.Error(err)
+	} else {
+		graph.Edges["r-v"].Attributes.Set("weight", 0.5)
+		graph.Edges["s-w"].Attributes.Set("weight", 3)
+
+		if diameter, err := graph.Diameter("weight"); err == nil {
+			AssertT(t, "Weighted diameter", 6.5, diameter)
+		} else {
+			t.Error(err)
+		}
+
+		graph.Edges["s-w"].Attributes.Set("weight", -1)
+		var edgeErr *EdgeError
+		if _, err := graph.Eccentricity("weight"); !errors.Is(err, ErrNegativeWeight) || !errors.As(err, &edgeErr) {
+			printError(t, "Negative weight", ErrNegativeWeight, err)
+		} else {
+			AssertT(t, "Negative weight edge", "s-w", edgeErr.ID)
+		}
+	}
+}
+
+func TestEccentricityDisconnected(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/d_dag_6n_8e.json"); err != nil {
+		t.Error(err)
+	} else if _, err = graph.Eccentricity(""); !errors.Is(err, ErrNotConnected) {
+		t.Error("Expected an error, not connected.")
+	}
+}
+
+func TestIFUBDiameter(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/ud_8n_9e.json"); err != nil {
+		t.Error(err)
+	} else {
+		if lower, upper, err := graph.IFUBDiameter(0); err == nil {
+			AssertT(t, "iFUB lower", 5, lower)
+			AssertT(t, "iFUB upper", 5, upper)
+		} else {
+			t.Error(err)
+		}
+
+		if lower, err := graph.DoubleSweepDiameter(); err == nil {
+			AssertT(t, "Double sweep", true, lower <= 5 && lower >= 3)
+		} else {
+			t.Error(err)
+		}
+	}
+
+	// the double sweep and the search from the middle take four searches.
+	if graph, err := LoadFileGraph("./data/ud_8n_9e.json"); err != nil {
+		t.Error(err)
+	} else if adj, err := newUndirectedAdjacency(graph, ""); err != nil {
+		t.Error(err)
+	} else if _, _, _, err = adj.doubleSweep(); err != nil {
+		t.Error(err)
+	} else {
+		AssertT(t, "Double sweep searches", 3, adj.searches)
+	}
+
+	if graph, err := LoadFileGraph("./data/ud_8n_9e.json"); err != nil {
+		t.Error(err)
+	} else if lower, upper, err := graph.IFUBDiameter(4); err == nil {
+		AssertT(t, "Budget bounds", true, lower <= 5 && upper >= 5)
+	} else {
+		t.Error(err)
+	}
+
+	for n := 2; n < 12; n++ {
+		var ids []string
+		for i := 0; i < n; i++ {
+			ids = append(ids, string(rune('a'+i)))
+		}
+
+		graph := buildPath(t, ids...)
+		if lower, upper, err := graph.IFUBDiameter(0); err == nil {
+			AssertT(t, "Path lower", n-1, lower)
+			AssertT(t, "Path upper", n-1, upper)
+		} else {
+			t.Error(err)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDominators(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/d_cfg_6n_7e.json"); err != nil {
+		t.Error(err)
+	} else {
+		dominators, err := graph.Dominators("entry")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		expected := map[string]string{"loop": "entry", "then": "loop", "else": "loop", "join": "loop", "exit": "loop"}
+		AssertWithCheckFunc(t, "Immediate dominators", expected, dominators.Idom, reflect.DeepEqual)
+
+		AssertT(t, "Tree nodes", 6, len(dominators.Tree.Nodes))
+		AssertT(t, "Tree edges", 5, len(dominators.Tree.Edges))
+		if _, ok := dominators.Tree.Edges["loop-join"]; !ok {
+			t.Error("Expected the tree edge loop-join.")
+		}
+
+		AssertWithCheckFunc(t, "Frontier[then]", []string{"join"}, dominators.Frontiers["then"], reflect.DeepEqual)
+		AssertWithCheckFunc(t, "Frontier[join]", []string{"loop"}, dominators.Frontiers["join"], reflect.DeepEqual)
+		AssertWithCheckFunc(t, "Frontier[loop]", []string{"loop"}, dominators.Frontiers["loop"], reflect.DeepEqual)
+		AssertWithCheckFunc(t, "Frontier[entry]", []string{}, dominators.Frontiers["entry"], reflect.DeepEqual)
+	}
+}
+
+func TestPostDominators(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/d_cfg_6n_7e.json"); err != nil {
+		t.Error(err)
+	} else {
+		dominators, err := graph.PostDominators("exit")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		expected := map[string]string{"entry": "loop", "loop": "exit", "then": "join", "else": "join", "join": "loop"}
+		AssertWithCheckFunc(t, "Immediate post-dominators", expected, dominators.Idom, reflect.DeepEqual)
+		AssertWithCheckFunc(t, "Frontier[then]", []string{"loop"}, dominators.Frontiers["then"], reflect.DeepEqual)
+	}
+}
+
+func TestDominatorsUnknownEntry(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/d_cfg_6n_7e.json"); err != nil {
+		t.Error(err)
+	} else if _, err = graph.Dominators("start"); err == nil {
+		t.Error("Expected an error, unknown entry.")
+	}
+}
+
+func TestDominanceFrontierOfEntry(t *testing.T) {
+	graph := buildGraph(t, GraphDirected, []string{"a", "b", "c"}, [][]string{{"a", "b"}, {"b", "a"}, {"b", "c"}})
+
+	dominators, err := graph.Dominators("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string][]string{"a": {"a"}, "b": {"a"}, "c": {}}
+	AssertT(t, "Frontiers", true, reflect.DeepEqual(expected, dominators.Frontiers))
+}
@@ -1,7 +1,5 @@
 package graph
 
-import "errors"
-
 // Edge is the connections between nodes.
 type Edge struct {
 
@@ -13,10 +11,26 @@ type Edge struct {
 
 	// Nodes this edge is connected to. NOTE: This could be used for hyper-graphs.
 	Nodes map[string]*Node
+
+	// Order the nodes were connected in. For directed graphs the first node is
+	// the source of the edge and the remaining nodes are its targets.
+	Order []*Node
+
+	// Tail holds the input nodes of a directed hyperedge, nil for other edges.
+	Tail []*Node
+
+	// Head holds the output nodes of a directed hyperedge, nil for other edges.
+	Head []*Node
 }
 
-// NewEdge creates a new edge.
+// NewEdge creates a new edge. A loop connects a node to itself by repeating it.
 func NewEdge(attrs AttributeCollection, connects []*Node) (edge *Edge, err error) {
+	return newEdge(attrs, connects, 2)
+}
+
+// newEdge will create an edge connecting atleast least nodes. An edge without
+// nodes needs an explicit id.
+func newEdge(attrs AttributeCollection, connects []*Node, least int) (edge *Edge, err error) {
 
 	edge = &Edge{}
 	edge.Attributes = attrs
@@ -25,28 +39,25 @@ func NewEdge(attrs AttributeCollection, connects []*Node) (edge *Edge, err error
 	// make the id.
 	if edge.Attributes.Contains("id") {
 		id, _ := edge.Attributes.Get("id")
-		if idStr := id.(string); len(idStr) > 0 {
+		if idStr, ok := id.(string); ok && len(idStr) > 0 {
 			edge.ID = idStr
 			edge.Attributes.Remove("id")
 		} else {
-			err = errors.New("Invalid edge id")
+			err = &EdgeError{Err: ErrInvalidEdgeID}
 		}
 	} else {
-		for _, node := range connects {
-			if len(edge.ID) == 0 {
-				edge.ID = node.ID
-			} else {
-				edge.ID += "-" + node.ID
-			}
-		}
+		edge.ID = defaultEdgeID(connects)
 	}
 
-	if len(connects) < 2 {
-		err = errors.New("Edges must have atleast two nodes to connect")
+	if len(connects) < least {
+		err = &EdgeError{ID: edge.ID, Err: ErrTooFewEndpoints}
+	} else if err == nil && len(edge.ID) == 0 {
+		err = &EdgeError{Err: ErrInvalidEdgeID}
 	}
 
 	// add the edge to the nodes collection.
 	if err == nil {
+		edge.Order = connects
 		for _, node := range connects {
 			edge.Nodes[node.ID] = node
 			node.Edges[edge.ID] = edge
@@ -57,3 +68,91 @@ func NewEdge(attrs AttributeCollection, connects []*Node) (edge *Edge, err error
 
 	return
 }
+
+// NewHyperedge creates a directed hyperedge taking the tail nodes to the head
+// nodes. Both sets must hold atleast one node, repeated nodes are dropped. The
+// default id joins the tail and the head node ids with '+', the sets separated
+// by '->'.
+func NewHyperedge(attrs AttributeCollection, tail []*Node, head []*Node) (edge *Edge, err error) {
+	tail, head = distinctNodes(tail), distinctNodes(head)
+	if len(tail) == 0 || len(head) == 0 {
+		return nil, &EdgeError{ID: explicitID(attrs), Err: ErrMissingTailOrHead}
+	}
+
+	if !attrs.Contains("id") {
+		attrs.Set("id", defaultHyperedgeID(tail, head))
+	}
+
+	if edge, err = NewEdge(attrs, append(append([]*Node{}, tail...), head...)); err == nil {
+		edge.Tail = tail
+		edge.Head = head
+	}
+
+	return
+}
+
+// IsLoop will check if the edge connects a single node to itself. The
+// hyperedge holding a single node connects it to nothing and is no loop.
+func (edge *Edge) IsLoop() bool {
+	return len(edge.Nodes) == 1 && len(edge.Order) > 1
+}
+
+// Ends will return the nodes the edge leaves from and the nodes it leads to. For
+// directed hyperedges these are the tail and the head, for other edges the
+// first node connected and the remaining ones.
+func (edge *Edge) Ends() (tail []*Node, head []*Node) {
+	if edge.Tail != nil {
+		tail, head = edge.Tail, edge.Head
+	} else if len(edge.Order) != 0 {
+		tail, head = edge.Order[:1], edge.Order[1:]
+	}
+	return
+}
+
+// defaultEdgeID will join the ids of the connected nodes with '-'.
+func defaultEdgeID(connects []*Node) (id string) {
+	for i, node := range connects {
+		if i > 0 {
+			id += "-"
+		}
+		id += node.ID
+	}
+	return
+}
+
+// defaultHyperedgeID will join the distinct tail and head node ids.
+func defaultHyperedgeID(tail []*Node, head []*Node) string {
+	return joinIds(tail) + "->" + joinIds(head)
+}
+
+// explicitID will read the id set in the attributes of an edge to create, empty
+// when there is none.
+func explicitID(attrs AttributeCollection) (id string) {
+	if value, ok := attrs.Get("id"); ok {
+		id, _ = value.(string)
+	}
+	return
+}
+
+// distinctNodes will drop the repeated nodes, keeping the first occurrence.
+func distinctNodes(nodes []*Node) (distinct []*Node) {
+	seen := map[*Node]bool{}
+	for _, node := range nodes {
+		if !seen[node] {
+			seen[node] = true
+			distinct = append(distinct, node)
+		}
+	}
+	return
+}
+
+// joinIds will join the node ids with '+'.
+func joinIds(nodes []*Node) (ids string) {
+	for i, node := range nodes {
+		if i > 0 {
+			ids += "+"
+		}
+		ids += node.ID
+	}
+	return
+}
@@ -0,0 +1,173 @@
+package graph
+
+import "errors"
+
+var (
+
+	// ErrInvalidNodeID is returned for a node without a valid id.
+	ErrInvalidNodeID = errors.New("Node must have a valid id")
+
+	// ErrInvalidEdgeID is returned for an edge with an invalid id.
+	ErrInvalidEdgeID = errors.New("Invalid edge id")
+
+	// ErrDuplicateEdgeID is returned when a multigraph already has an edge with the id.
+	ErrDuplicateEdgeID = errors.New("Edge id already used")
+
+	// ErrTooFewEndpoints is returned for an edge connecting less than two nodes.
+	ErrTooFewEndpoints = errors.New("Edges must have atleast two nodes to connect")
+
+	// ErrMissingTailOrHead is returned for a directed hyperedge with an empty tail
+	// or head.
+	ErrMissingTailOrHead = errors.New("Hyperedges must have atleast one tail and one head node")
+
+	// ErrHyperedgeSets is returned when loading a hyperedge that does not hold
+	// exactly a tail and a head set.
+	ErrHyperedgeSets = errors.New("Hyperedges must have exactly a tail and a head set")
+
+	// ErrUnknownGraphType is returned for a graph of a type that is not known.
+	ErrUnknownGraphType = errors.New("Unknown graph type")
+
+	// ErrNotDirectedHypergraph is returned when adding a directed hyperedge to
+	// another type of graph.
+	ErrNotDirectedHypergraph = errors.New("Tail and head sets require a directed hypergraph")
+
+	// ErrNodeNotFound is returned for a node id that is not in the graph.
+	ErrNodeNotFound = errors.New("Unknown node id")
+
+	// ErrEdgeNotFound is returned for an edge id that is not in the graph.
+	ErrEdgeNotFound = errors.New("Unknown edge id")
+
+	// ErrNegativeWeight is returned by the shortest path searches for an edge
+	// with a negative weight.
+	ErrNegativeWeight = errors.New("Edge weights must not be negative")
+
+	// ErrNonNumericWeight is returned for an edge whose weight is not a number.
+	ErrNonNumericWeight = errors.New("Edge weights must be numeric")
+
+	// ErrNotSimpleEdge is returned by the algorithms requiring every edge to join
+	// two nodes.
+	ErrNotSimpleEdge = errors.New("Algorithm requires edges between two nodes")
+
+	// ErrNotUndirected is returned by the algorithms only defined for undirected
+	// graphs.
+	ErrNotUndirected = errors.New("Algorithm requires an undirected graph")
+
+	// ErrNotDirected is returned by the algorithms only defined for directed graphs.
+	ErrNotDirected = errors.New("Algorithm requires a directed graph")
+
+	// ErrMixedDirection is returned when comparing a directed graph with an
+	// undirected one.
+	ErrMixedDirection = errors.New("Graphs must both be directed or undirected")
+
+	// ErrNotConnected is returned by the distance metrics when some node cannot be
+	// reached.
+	ErrNotConnected = errors.New("Graph is not connected")
+
+	// ErrNotAcyclic is returned by the algorithms only defined for acyclic graphs.
+	ErrNotAcyclic = errors.New("Graph must be acyclic")
+
+	// ErrTooFewNodes is returned by the spectral algorithms for a graph of less
+	// than two nodes.
+	ErrTooFewNodes = errors.New("Graph must have atleast two nodes")
+
+	// ErrNoEdges is returned by the eigenvector centrality of a graph without edges.
+	ErrNoEdges = errors.New("Eigenvector centrality is undefined for graphs without edges")
+
+	// ErrNotSquare is returned for a matrix that is not square.
+	ErrNotSquare = errors.New("Matrix must be square")
+
+	// ErrTooManyEigenpairs is returned when asking for more eigenpairs than the
+	// dimension of the matrix.
+	ErrTooManyEigenpairs = errors.New("More eigenpairs requested than the matrix dimension")
+
+	// ErrNotConverged is returned when the eigen-solver gives up.
+	ErrNotConverged = errors.New("Eigen-solver did not converge")
+
+	// ErrNoCommunity is returned for a node missing from the communities.
+	ErrNoCommunity = errors.New("Node has no community")
+
+	// ErrInvalidS is returned for an s below 1 in the s-walks of a hypergraph.
+	ErrInvalidS = errors.New("Invalid s, must be atleast 1")
+
+	// ErrTooSmallHyperedge is returned for a hyperedge of fewer than s nodes,
+	// which takes no part in s-walks.
+	ErrTooSmallHyperedge = errors.New("Hyperedge has fewer than s nodes")
+
+	// ErrSharedID is returned when a hyperedge has the id of a node.
+	ErrSharedID = errors.New("Hyperedge id is also a node id")
+
+	// ErrNoIterationFunc is returned by the iterators without an iteration function.
+	ErrNoIterationFunc = errors.New("No iteration function")
+
+	// ErrNotSortable is returned when iterating over attribute values that cannot
+	// be sorted.
+	ErrNotSortable = errors.New("Non sortable type. Requires string, int or float64")
+)
+
+// NodeError is the error about a node, carrying its id.
+type NodeError struct {
+
+	// ID of the node, empty when it has none.
+	ID string
+
+	// Err is the sentinel error describing the problem.
+	Err error
+}
+
+// Error will describe the problem, followed by the node id.
+func (err *NodeError) Error() string {
+	return describe(err.Err, err.ID)
+}
+
+// Unwrap will return the sentinel error.
+func (err *NodeError) Unwrap() error {
+	return err.Err
+}
+
+// EdgeError is the error about an edge, carrying its id.
+type EdgeError struct {
+
+	// ID of the edge, empty when it has none.
+	ID string
+
+	// Err is the sentinel error describing the problem.
+	Err error
+}
+
+// Error will describe the problem, followed by the edge id.
+func (err *EdgeError) Error() string {
+	return describe(err.Err, err.ID)
+}
+
+// Unwrap will return the sentinel error.
+func (err *EdgeError) Unwrap() error {
+	return err.Err
+}
+
+// GraphTypeError is the error about the type of a graph.
+type GraphTypeError struct {
+
+	// Type of the graph.
+	Type Type
+
+	// Err is the sentinel error describing the problem.
+	Err error
+}
+
+// Error will describe the problem, followed by the graph type.
+func (err *GraphTypeError) Error() string {
+	return describe(err.Err, string(err.Type))
+}
+
+// Unwrap will return the sentinel error.
+func (err *GraphTypeError) Unwrap() error {
+	return err.Err
+}
+
+// describe will follow the message of the error with the id, if there is one.
+func describe(err error, id string) string {
+	if len(id) == 0 {
+		return err.Error()
+	}
+	return err.Error() + ": " + id
+}
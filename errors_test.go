@@ -0,0 +1,49 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestErrorMessages(t *testing.T) {
+	AssertT(t, "Node error", "Unknown node id: 7", (&NodeError{ID: "7", Err: ErrNodeNotFound}).Error())
+	AssertT(t, "Node error without id", "Node must have a valid id", (&NodeError{Err: ErrInvalidNodeID}).Error())
+	AssertT(t, "Edge error", "Unknown edge id: e", (&EdgeError{ID: "e", Err: ErrEdgeNotFound}).Error())
+	AssertT(t, "Graph type error", "Unknown graph type: foo", (&GraphTypeError{Type: "foo", Err: ErrUnknownGraphType}).Error())
+}
+
+func TestAlgorithmErrors(t *testing.T) {
+	graph, err := LoadFileGraph("./data/d_cfg_6n_7e.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = graph.Dominators("missing")
+
+	var nodeErr *NodeError
+	if !errors.Is(err, ErrNodeNotFound) || !errors.As(err, &nodeErr) {
+		t.Error("Expected a node error, got", err)
+	} else {
+		AssertT(t, "Node id", "missing", nodeErr.ID)
+	}
+
+	if _, err = (&Graph{Type: "foo"}).Triangles(); !errors.Is(err, ErrUnknownGraphType) {
+		t.Error("Expected a graph type error, got", err)
+	}
+
+	var typeErr *GraphTypeError
+	if _, err = graph.Triangles(); !errors.Is(err, ErrNotUndirected) || !errors.As(err, &typeErr) {
+		t.Error("Expected a graph type error, got", err)
+	} else {
+		AssertT(t, "Graph type", GraphDirected, typeErr.Type)
+	}
+
+	if err = graph.IterNodes("foo", nil); !errors.Is(err, ErrNoIterationFunc) {
+		t.Error("Expected no iteration function, got", err)
+	}
+
+	graph.Nodes["entry"].Attributes.Set("foo", true)
+	if err = graph.IterNodes("foo", func(node *Node) (bool, error) { return true, nil }); !errors.Is(err, ErrNotSortable) {
+		t.Error("Expected a non sortable type, got", err)
+	}
+}
@@ -0,0 +1,318 @@
+package generate
+
+import (
+	"math"
+	"strconv"
+
+	graph "github.com/mkreibe/gograph"
+)
+
+// Complete creates the complete graph of n nodes.
+func Complete(n int) (g *graph.Graph, err error) {
+	if n < 0 {
+		return nil, &ParameterError{Requires: "n >= 0"}
+	}
+
+	edges := newEdgeSet()
+	for u := 0; u < n; u++ {
+		for v := u + 1; v < n; v++ {
+			edges.add(u, v)
+		}
+	}
+
+	return build(n, edges.order)
+}
+
+// CompleteBipartite creates the complete bipartite graph with n1 nodes on one
+// side, numbered first, and n2 on the other. Every node has a 'bipartite'
+// attribute of 0 or 1 naming its side.
+func CompleteBipartite(n1 int, n2 int) (g *graph.Graph, err error) {
+	if n1 < 0 || n2 < 0 {
+		return nil, &ParameterError{Requires: "n1 >= 0 and n2 >= 0"}
+	}
+
+	edges := newEdgeSet()
+	for u := 0; u < n1; u++ {
+		for v := n1; v < n1+n2; v++ {
+			edges.add(u, v)
+		}
+	}
+
+	if g, err = build(n1+n2, edges.order); err == nil {
+		for u := 0; u < n1+n2; u++ {
+			side := 0
+			if u >= n1 {
+				side = 1
+			}
+			g.Nodes[strconv.Itoa(u)].Attributes.Set("bipartite", side)
+		}
+	}
+
+	return
+}
+
+// Path creates the path through n nodes in order.
+func Path(n int) (g *graph.Graph, err error) {
+	if n < 0 {
+		return nil, &ParameterError{Requires: "n >= 0"}
+	}
+
+	edges := newEdgeSet()
+	for u := 1; u < n; u++ {
+		edges.add(u-1, u)
+	}
+
+	return build(n, edges.order)
+}
+
+// Cycle creates the cycle through n nodes in order.
+func Cycle(n int) (g *graph.Graph, err error) {
+	if n < 3 {
+		return nil, &ParameterError{Requires: "n >= 3"}
+	}
+
+	edges := newEdgeSet()
+	for u := 0; u < n; u++ {
+		edges.add(u, (u+1)%n)
+	}
+
+	return build(n, edges.order)
+}
+
+// Star creates the star with node 0 at the center and n leaves.
+func Star(n int) (g *graph.Graph, err error) {
+	if n < 0 {
+		return nil, &ParameterError{Requires: "n >= 0"}
+	}
+
+	edges := newEdgeSet()
+	for u := 1; u <= n; u++ {
+		edges.add(0, u)
+	}
+
+	return build(n+1, edges.order)
+}
+
+// Wheel creates the wheel of n nodes: node 0 at the hub, linked to a cycle
+// through the other n-1 nodes.
+func Wheel(n int) (g *graph.Graph, err error) {
+	if n < 4 {
+		return nil, &ParameterError{Requires: "n >= 4"}
+	}
+
+	edges := newEdgeSet()
+	for u := 1; u < n; u++ {
+		edges.add(0, u)
+		edges.add(u, u%(n-1)+1)
+	}
+
+	return build(n, edges.order)
+}
+
+// Grid2D creates the rows by cols grid. Nodes are numbered row by row and, when
+// periodic, the borders wrap around into a torus. See Lattice for the
+// coordinates.
+func Grid2D(rows int, cols int, periodic bool, coordinates bool) (g *graph.Graph, err error) {
+	return Lattice([]int{rows, cols}, periodic, coordinates)
+}
+
+// Lattice creates the hypercubic lattice with the given size along every
+// dimension, linking the nodes one step apart along a single dimension. Nodes
+// are numbered with the last dimension varying fastest. When periodic, the
+// borders of every dimension longer than two wrap around. With coordinates,
+// every node gets a 'coords' attribute holding its position as []int.
+func Lattice(dims []int, periodic bool, coordinates bool) (g *graph.Graph, err error) {
+	n := 1
+	for _, size := range dims {
+		if size < 1 {
+			return nil, &ParameterError{Requires: "every dimension to be >= 1"}
+		}
+		n *= size
+	}
+	if len(dims) == 0 {
+		n = 0
+	}
+
+	// stride of every dimension in the node numbering.
+	strides := make([]int, len(dims))
+	stride := 1
+	for i := len(dims) - 1; i >= 0; i-- {
+		strides[i] = stride
+		stride *= dims[i]
+	}
+
+	position := func(u int) (coords []int) {
+		coords = make([]int, len(dims))
+		for i := range dims {
+			coords[i] = u / strides[i] % dims[i]
+		}
+		return
+	}
+
+	edges := newEdgeSet()
+	for u := 0; u < n; u++ {
+		coords := position(u)
+		for i, size := range dims {
+			if coords[i]+1 < size {
+				edges.add(u, u+strides[i])
+			} else if periodic && size > 2 {
+				edges.add(u, u-coords[i]*strides[i])
+			}
+		}
+	}
+
+	if g, err = build(n, edges.order); err == nil && coordinates {
+		for u := 0; u < n; u++ {
+			g.Nodes[strconv.Itoa(u)].Attributes.Set("coords", position(u))
+		}
+	}
+
+	return
+}
+
+// Hypercube creates the hypercube of dimension d: 2^d nodes linked when their
+// numbers differ in a single bit. With coordinates, every node gets a 'coords'
+// attribute holding its bits as []int, most significant first.
+func Hypercube(d int, coordinates bool) (g *graph.Graph, err error) {
+	if d < 0 {
+		return nil, &ParameterError{Requires: "d >= 0"}
+	}
+
+	dims := make([]int, d)
+	for i := range dims {
+		dims[i] = 2
+	}
+
+	if d == 0 {
+		return build(1, nil)
+	}
+
+	return Lattice(dims, false, coordinates)
+}
+
+// BalancedTree creates the tree of the given height where every inner node has
+// r children. Nodes are numbered breadth first from the root 0.
+func BalancedTree(r int, height int) (g *graph.Graph, err error) {
+	if r < 1 || height < 0 {
+		return nil, &ParameterError{Requires: "r >= 1 and height >= 0"}
+	}
+
+	n := 1
+	for level, width := 0, 1; level < height; level++ {
+		width *= r
+		n += width
+	}
+
+	edges := newEdgeSet()
+	for u := 1; u < n; u++ {
+		edges.add((u-1)/r, u)
+	}
+
+	return build(n, edges.order)
+}
+
+// BinaryTree creates the full binary tree of the given height.
+func BinaryTree(height int) (g *graph.Graph, err error) {
+	return BalancedTree(2, height)
+}
+
+// LCF creates the cubic Hamiltonian graph of the LCF notation: a cycle through
+// n nodes where node i also links to node i + shifts[i mod len(shifts)].
+func LCF(n int, shifts []int, repeats int) (g *graph.Graph, err error) {
+	if n < 3 || len(shifts) == 0 || repeats < 1 {
+		return nil, &ParameterError{Requires: "n >= 3 and some shifts"}
+	}
+
+	edges := newEdgeSet()
+	for u := 0; u < n; u++ {
+		edges.add(u, (u+1)%n)
+	}
+
+	for i := 0; i < len(shifts)*repeats; i++ {
+		u := i % n
+		v := ((u+shifts[i%len(shifts)])%n + n) % n
+		if u != v {
+			edges.add(u, v)
+		}
+	}
+
+	return build(n, edges.order)
+}
+
+// Petersen creates the Petersen graph: an outer 5-cycle 0-4, an inner
+// pentagram 5-9 and the spokes between them.
+func Petersen() (g *graph.Graph, err error) {
+	edges := newEdgeSet()
+	for u := 0; u < 5; u++ {
+		edges.add(u, (u+1)%5)
+		edges.add(u, u+5)
+		edges.add(u+5, (u+2)%5+5)
+	}
+
+	return build(10, edges.order)
+}
+
+// Heawood creates the Heawood graph, the (3,6)-cage.
+func Heawood() (g *graph.Graph, err error) {
+	return LCF(14, []int{5, -5}, 7)
+}
+
+// MoebiusKantor creates the Moebius-Kantor graph.
+func MoebiusKantor() (g *graph.Graph, err error) {
+	return LCF(16, []int{5, -5}, 8)
+}
+
+// Dodecahedral creates the graph of the dodecahedron.
+func Dodecahedral() (g *graph.Graph, err error) {
+	return LCF(20, []int{10, 7, 4, -4, -7, 10, -4, 7, -7, 4}, 2)
+}
+
+// Desargues creates the Desargues graph.
+func Desargues() (g *graph.Graph, err error) {
+	return LCF(20, []int{5, -5, 9, -9}, 5)
+}
+
+// Bull creates the bull graph: a triangle 0-1-2 with the horns 3 and 4 on
+// nodes 1 and 2.
+func Bull() (g *graph.Graph, err error) {
+	return build(5, []pair{{0, 1}, {0, 2}, {1, 2}, {1, 3}, {2, 4}})
+}
+
+// Diamond creates the diamond graph: two triangles sharing the edge 1-2.
+func Diamond() (g *graph.Graph, err error) {
+	return build(4, []pair{{0, 1}, {0, 2}, {1, 2}, {1, 3}, {2, 3}})
+}
+
+// House creates the house graph: the square 0-1-3-2 with the roof 4 on 2 and 3.
+func House() (g *graph.Graph, err error) {
+	return build(5, []pair{{0, 1}, {0, 2}, {1, 3}, {2, 3}, {2, 4}, {3, 4}})
+}
+
+// KrackhardtKite creates the Krackhardt kite social network.
+func KrackhardtKite() (g *graph.Graph, err error) {
+	return build(10, []pair{
+		{0, 1}, {0, 2}, {0, 3}, {0, 5},
+		{1, 3}, {1, 4}, {1, 6},
+		{2, 3}, {2, 5},
+		{3, 4}, {3, 5}, {3, 6},
+		{4, 6},
+		{5, 6}, {5, 7},
+		{6, 7},
+		{7, 8},
+		{8, 9},
+	})
+}
+
+// CircularCoordinates will give every node of the graph 'x' and 'y' attributes
+// placing them evenly on the unit circle, in the order of their numbers. Nodes
+// whose ids are not numbers are left alone.
+func CircularCoordinates(g *graph.Graph) {
+	n := len(g.Nodes)
+	for id, node := range g.Nodes {
+		if u, err := strconv.Atoi(id); err == nil {
+			angle := 2 * math.Pi * float64(u) / float64(n)
+			node.Attributes.Set("x", math.Cos(angle))
+			node.Attributes.Set("y", math.Sin(angle))
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package generate
+
+import (
+	"reflect"
+	"testing"
+
+	graph "github.com/mkreibe/gograph"
+)
+
+func assertShape(t *testing.T, name string, g *graph.Graph, err error, nodes int, edges int) {
+	if err != nil {
+		t.Error(name, err)
+	} else if len(g.Nodes) != nodes || len(g.Edges) != edges {
+		t.Errorf("%s: expected %d nodes and %d edges, got %d and %d", name, nodes, edges, len(g.Nodes), len(g.Edges))
+	}
+}
+
+func assertRegular(t *testing.T, name string, g *graph.Graph, degree int) {
+	for id, node := range g.Nodes {
+		if len(node.Adj()) != degree {
+			t.Errorf("%s: expected node %s to have degree %d, got %d", name, id, degree, len(node.Adj()))
+		}
+	}
+}
+
+func TestClassicGraphs(t *testing.T) {
+	g, err := Complete(6)
+	assertShape(t, "Complete", g, err, 6, 15)
+
+	g, err = CompleteBipartite(2, 3)
+	assertShape(t, "Complete bipartite", g, err, 5, 6)
+	if side, _ := g.Nodes["4"].Attributes.Get("bipartite"); side != 1 {
+		t.Error("Expected node 4 on side 1.")
+	}
+
+	g, err = Path(5)
+	assertShape(t, "Path", g, err, 5, 4)
+	if _, ok := g.Edges["3-4"]; !ok {
+		t.Error("Expected the path edge 3-4.")
+	}
+
+	g, err = Cycle(5)
+	assertShape(t, "Cycle", g, err, 5, 5)
+	assertRegular(t, "Cycle", g, 2)
+
+	g, err = Star(4)
+	assertShape(t, "Star", g, err, 5, 4)
+
+	g, err = Wheel(6)
+	assertShape(t, "Wheel", g, err, 6, 10)
+
+	g, err = BinaryTree(3)
+	assertShape(t, "Binary tree", g, err, 15, 14)
+
+	g, err = Hypercube(4, false)
+	assertShape(t, "Hypercube", g, err, 16, 32)
+	assertRegular(t, "Hypercube", g, 4)
+}
+
+func TestLattice(t *testing.T) {
+	g, err := Grid2D(3, 4, false, true)
+	assertShape(t, "Grid", g, err, 12, 17)
+	if coords, _ := g.Nodes["6"].Attributes.Get("coords"); !reflect.DeepEqual([]int{1, 2}, coords) {
+		t.Errorf("Expected node 6 at [1 2], got %v", coords)
+	}
+
+	g, err = Grid2D(3, 4, true, false)
+	assertShape(t, "Torus", g, err, 12, 24)
+	assertRegular(t, "Torus", g, 4)
+
+	g, err = Lattice([]int{3, 3, 3}, true, false)
+	assertShape(t, "Periodic cube", g, err, 27, 81)
+	assertRegular(t, "Periodic cube", g, 6)
+}
+
+func TestNamedGraphs(t *testing.T) {
+	g, err := Petersen()
+	assertShape(t, "Petersen", g, err, 10, 15)
+	assertRegular(t, "Petersen", g, 3)
+
+	g, err = Heawood()
+	assertShape(t, "Heawood", g, err, 14, 21)
+	assertRegular(t, "Heawood", g, 3)
+
+	g, err = MoebiusKantor()
+	assertShape(t, "Moebius-Kantor", g, err, 16, 24)
+	assertRegular(t, "Moebius-Kantor", g, 3)
+
+	g, err = Dodecahedral()
+	assertShape(t, "Dodecahedral", g, err, 20, 30)
+	assertRegular(t, "Dodecahedral", g, 3)
+
+	g, err = Desargues()
+	assertShape(t, "Desargues", g, err, 20, 30)
+	assertRegular(t, "Desargues", g, 3)
+
+	g, err = KrackhardtKite()
+	assertShape(t, "Krackhardt kite", g, err, 10, 18)
+
+	if g, err = Petersen(); err == nil {
+		if triangles, _ := g.Triangles(); triangles["0"] != 0 {
+			t.Error("Expected the Petersen graph to be triangle free.")
+		}
+	}
+}
+
+func TestCircularCoordinates(t *testing.T) {
+	g, _ := Cycle(4)
+	CircularCoordinates(g)
+
+	if x, _ := g.Nodes["0"].Attributes.Get("x"); x != 1.0 {
+		t.Errorf("Expected node 0 at x 1, got %v", x)
+	}
+}
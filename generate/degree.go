@@ -0,0 +1,229 @@
+package generate
+
+import (
+	"math/rand"
+	"sort"
+	"strconv"
+
+	graph "github.com/mkreibe/gograph"
+)
+
+// maxConfigurationTries is the number of pairings tried before a simple
+// configuration model gives up.
+const maxConfigurationTries = 1000
+
+// IsGraphical checks with the Havel-Hakimi algorithm if the degree sequence is
+// realised by a simple undirected graph.
+func IsGraphical(sequence []int) bool {
+	_, ok := havelHakimi(sequence)
+	return ok
+}
+
+// HavelHakimi creates a simple undirected graph where node i has the degree
+// sequence[i], repeatedly linking the node of highest remaining degree to the
+// next highest ones.
+func HavelHakimi(sequence []int) (g *graph.Graph, err error) {
+	if edges, ok := havelHakimi(sequence); ok {
+		g, err = build(len(sequence), edges)
+	} else {
+		err = ErrNotGraphical
+	}
+	return
+}
+
+// havelHakimi will realise the degree sequence, reporting false when it is not
+// graphical.
+func havelHakimi(sequence []int) (edges []pair, ok bool) {
+	remaining := append([]int{}, sequence...)
+	nodes := make([]int, len(sequence))
+	for u := range nodes {
+		nodes[u] = u
+		if remaining[u] < 0 {
+			return nil, false
+		}
+	}
+
+	for {
+		sort.SliceStable(nodes, func(i, j int) bool {
+			return remaining[nodes[i]] > remaining[nodes[j]]
+		})
+
+		if len(nodes) == 0 || remaining[nodes[0]] == 0 {
+			return edges, true
+		}
+
+		u := nodes[0]
+		if remaining[u] >= len(nodes) {
+			return nil, false
+		}
+
+		for _, v := range nodes[1 : remaining[u]+1] {
+			if remaining[v] == 0 {
+				return nil, false
+			}
+			remaining[v]--
+			edges = append(edges, newPair(u, v))
+		}
+
+		remaining[u] = 0
+		nodes = nodes[1:]
+	}
+}
+
+// ConfigurationModel creates a random graph where node i has the degree
+// sequence[i], by randomly pairing the edge ends. When simple, pairings holding
+// a loop or a parallel edge are rejected and drawn again. Otherwise those are
+// kept and a multigraph is returned, where a loop is an edge between a node and
+// itself.
+func ConfigurationModel(sequence []int, simple bool, source rand.Source) (g *graph.Graph, err error) {
+	total := 0
+	for _, d := range sequence {
+		if d < 0 {
+			return nil, ErrNegativeDegree
+		}
+		total += d
+	}
+
+	if total%2 != 0 {
+		return nil, ErrOddDegreeSum
+	}
+
+	random := newRandom(source)
+	for try := 0; try < maxConfigurationTries; try++ {
+		var stubs []int
+		for u, d := range sequence {
+			for i := 0; i < d; i++ {
+				stubs = append(stubs, u)
+			}
+		}
+
+		random.Shuffle(len(stubs), func(i, j int) {
+			stubs[i], stubs[j] = stubs[j], stubs[i]
+		})
+
+		var edges []pair
+		set := newEdgeSet()
+		accepted := true
+		for i := 0; i < len(stubs); i += 2 {
+			u, v := stubs[i], stubs[i+1]
+			if simple && (u == v || !set.add(u, v)) {
+				accepted = false
+				break
+			}
+			edges = append(edges, newPair(u, v))
+		}
+
+		if accepted {
+			return buildMulti(len(sequence), edges)
+		}
+	}
+
+	return nil, ErrNoSimpleGraph
+}
+
+// buildMulti will create the undirected multigraph of n nodes with the edges,
+// which may hold loops and parallel edges.
+func buildMulti(n int, edges []pair) (g *graph.Graph, err error) {
+	if g, err = build(n, nil); err != nil {
+		return
+	}
+	g.Multigraph = true
+
+	sort.Slice(edges, func(i, j int) bool {
+		return edges[i][0] < edges[j][0] || (edges[i][0] == edges[j][0] && edges[i][1] < edges[j][1])
+	})
+
+	for _, p := range edges {
+		if _, err = g.AddEdge(graph.NewAttributeCollection(), []string{strconv.Itoa(p[0]), strconv.Itoa(p[1])}); err != nil {
+			return
+		}
+	}
+
+	return
+}
+
+// DoubleEdgeSwap randomises a simple undirected graph while keeping the degree
+// of every node. It repeatedly picks two edges u-v and x-y and rewires them to
+// u-x and v-y, skipping the swaps that would create a loop or a parallel edge,
+// until the number of swaps is done or maxTries attempts were made. The rewired
+// edges get their default ids, smallest node id first, and keep the attributes
+// of the edges they replace. It returns the number of swaps done.
+func DoubleEdgeSwap(g *graph.Graph, swaps int, maxTries int, source rand.Source) (done int, err error) {
+	if g.Type != graph.GraphUndirected {
+		return 0, &graph.GraphTypeError{Type: g.Type, Err: graph.ErrNotUndirected}
+	} else if len(g.Edges) < 2 {
+		return 0, ErrTooFewEdges
+	}
+
+	var edges []*graph.Edge
+	for _, edge := range g.Edges {
+		if len(edge.Order) != 2 || edge.IsLoop() {
+			return 0, &graph.EdgeError{ID: edge.ID, Err: graph.ErrNotSimpleEdge}
+		}
+		edges = append(edges, edge)
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		return edges[i].ID < edges[j].ID
+	})
+
+	random := newRandom(source)
+	for tries := 0; done < swaps && tries < maxTries; tries++ {
+		i, j := random.Intn(len(edges)), random.Intn(len(edges))
+		if i == j {
+			continue
+		}
+
+		u, v := edges[i].Order[0], edges[i].Order[1]
+		x, y := edges[j].Order[0], edges[j].Order[1]
+		if random.Intn(2) == 0 {
+			x, y = y, x
+		}
+
+		if u == x || v == y || linked(u, x) || linked(v, y) {
+			continue
+		}
+
+		var first, second *graph.Edge
+		if first, err = g.RemoveEdge(edges[i].ID); err != nil {
+			return
+		} else if second, err = g.RemoveEdge(edges[j].ID); err != nil {
+			return
+		}
+
+		if edges[i], err = g.AddEdge(first.Attributes.Copy(), orderedIds(u, x)); err != nil {
+			return
+		} else if edges[j], err = g.AddEdge(second.Attributes.Copy(), orderedIds(v, y)); err != nil {
+			return
+		}
+
+		done++
+	}
+
+	return
+}
+
+// orderedIds will put the smallest of the two node ids first, comparing them as
+// numbers when both are, like the edges of the generated graphs.
+func orderedIds(a *graph.Node, b *graph.Node) []string {
+	swap := b.ID < a.ID
+	if x, err := strconv.Atoi(a.ID); err == nil {
+		if y, err := strconv.Atoi(b.ID); err == nil {
+			swap = y < x
+		}
+	}
+
+	if swap {
+		a, b = b, a
+	}
+	return []string{a.ID, b.ID}
+}
+
+// linked will check if an edge joins the nodes.
+func linked(a *graph.Node, b *graph.Node) bool {
+	for _, edge := range a.Edges {
+		if _, ok := edge.Nodes[b.ID]; ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,159 @@
+package generate
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"testing"
+
+	graph "github.com/mkreibe/gograph"
+)
+
+func degrees(g *graph.Graph) (sequence []int) {
+	sequence = make([]int, len(g.Nodes))
+	for id, node := range g.Nodes {
+		u, _ := strconv.Atoi(id)
+		sequence[u] = node.Degree()
+	}
+	return
+}
+
+func TestIsGraphical(t *testing.T) {
+	for _, sequence := range [][]int{{}, {0}, {1, 1}, {3, 3, 3, 3}, {2, 2, 2}, {3, 2, 2, 2, 1}} {
+		if !IsGraphical(sequence) {
+			t.Error("Expected graphical:", sequence)
+		}
+	}
+
+	for _, sequence := range [][]int{{1}, {2, 0}, {3, 3, 1, 1}, {4, 1, 1, 1}, {-1, 1}, {1, 1, 1}} {
+		if IsGraphical(sequence) {
+			t.Error("Expected not graphical:", sequence)
+		}
+	}
+}
+
+func TestHavelHakimi(t *testing.T) {
+	sequence := []int{3, 2, 2, 2, 1, 0}
+	g, err := HavelHakimi(sequence)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(g.Nodes) != 6 || len(g.Edges) != 5 {
+		t.Errorf("Expected 6 nodes and 5 edges, got %d and %d", len(g.Nodes), len(g.Edges))
+	}
+
+	for i, id := range []string{"0", "1", "2", "3", "4", "5"} {
+		if d := len(g.Nodes[id].Edges); d != sequence[i] {
+			t.Errorf("Node %s has degree %d, expected %d", id, d, sequence[i])
+		}
+	}
+
+	if _, err = HavelHakimi([]int{3, 1}); !errors.Is(err, ErrNotGraphical) {
+		t.Error("Expected an error for a non graphical sequence")
+	}
+}
+
+func TestConfigurationModel(t *testing.T) {
+	sequence := []int{3, 3, 2, 2, 2, 1, 1}
+
+	for _, simple := range []bool{true, false} {
+		g := assertDeterministic(t, "configuration model", func(source rand.Source) (*graph.Graph, error) {
+			return ConfigurationModel(sequence, simple, source)
+		})
+
+		got := degrees(g)
+		for i := range sequence {
+			if got[i] != sequence[i] {
+				t.Errorf("Node %d has degree %d, expected %d", i, got[i], sequence[i])
+			}
+		}
+
+		if simple {
+			for _, edge := range g.Edges {
+				if len(edge.Nodes) != 2 {
+					t.Error("Simple configuration model created loop", edge.ID)
+				}
+			}
+		}
+	}
+
+	// a single node of degree 2 can only be realised by a loop.
+	g, err := ConfigurationModel([]int{2}, false, rand.NewSource(1))
+	if err != nil {
+		t.Fatal(err)
+	} else if _, ok := g.Edges["0-0"]; !ok || len(g.Edges) != 1 {
+		t.Error("Expected the loop 0-0")
+	}
+
+	// two nodes of degree 2 can only be realised by loops or parallel edges.
+	if g, err = ConfigurationModel([]int{2, 2}, false, rand.NewSource(3)); err != nil {
+		t.Fatal(err)
+	} else if len(g.Edges) != 2 || !g.Multigraph {
+		t.Error("Expected a multigraph of 2 edges, got", len(g.Edges))
+	}
+
+	if _, err = ConfigurationModel([]int{2, 2}, true, rand.NewSource(1)); !errors.Is(err, ErrNoSimpleGraph) {
+		t.Error("Expected an error for a sequence without simple realisation")
+	}
+
+	if _, err = ConfigurationModel([]int{1, 2}, false, rand.NewSource(1)); !errors.Is(err, ErrOddDegreeSum) {
+		t.Error("Expected an error for an odd degree sum")
+	}
+}
+
+func TestDoubleEdgeSwap(t *testing.T) {
+	ring := func() *graph.Graph {
+		g, err := WattsStrogatz(30, 4, 0, rand.NewSource(1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return g
+	}
+
+	g := assertDeterministic(t, "double edge swap", func(source rand.Source) (g *graph.Graph, err error) {
+		g = ring()
+		var done int
+		if done, err = DoubleEdgeSwap(g, 40, 1000, source); err == nil && done != 40 {
+			t.Error("Expected 40 swaps, got", done)
+		}
+		return
+	})
+
+	original := ring()
+	for id, node := range original.Nodes {
+		if len(g.Nodes[id].Edges) != len(node.Edges) {
+			t.Errorf("Node %s changed degree", id)
+		}
+	}
+
+	if len(g.Edges) != len(original.Edges) {
+		t.Error("Expected the number of edges to stay", len(original.Edges))
+	} else if reflect.DeepEqual(edgeIds(g), edgeIds(original)) {
+		t.Error("Expected the swaps to rewire the graph")
+	}
+
+	for _, edge := range g.Edges {
+		if len(edge.Nodes) != 2 {
+			t.Error("Swap created a loop", edge.ID)
+		}
+
+		u, _ := strconv.Atoi(edge.Order[0].ID)
+		v, _ := strconv.Atoi(edge.Order[1].ID)
+		if u > v || edge.ID != edge.Order[0].ID+"-"+edge.Order[1].ID {
+			t.Error("Swap left the nodes of an edge out of order", edge.ID)
+		}
+
+		for _, other := range g.Edges {
+			if other != edge && other.Nodes[edge.Order[0].ID] != nil && other.Nodes[edge.Order[1].ID] != nil {
+				t.Error("Swap created a parallel edge", edge.ID, other.ID)
+			}
+		}
+	}
+
+	directed, _ := graph.NewGraph(graph.GraphDirected)
+	if _, err := DoubleEdgeSwap(directed, 1, 1, rand.NewSource(1)); !errors.Is(err, graph.ErrNotUndirected) {
+		t.Error("Expected an error for a directed graph")
+	}
+}
@@ -0,0 +1,45 @@
+package generate
+
+import "errors"
+
+var (
+
+	// ErrInvalidParameter is returned for model parameters out of their range.
+	ErrInvalidParameter = errors.New("Invalid parameter")
+
+	// ErrNotGraphical is returned for a degree sequence no simple graph has.
+	ErrNotGraphical = errors.New("Degree sequence is not graphical")
+
+	// ErrNegativeDegree is returned for a degree sequence holding a negative degree.
+	ErrNegativeDegree = errors.New("Degrees must not be negative")
+
+	// ErrOddDegreeSum is returned for a degree sequence of odd sum, which no
+	// graph has.
+	ErrOddDegreeSum = errors.New("Sum of the degrees must be even")
+
+	// ErrTooFewEdges is returned when swapping the edges of a graph with less than
+	// two edges.
+	ErrTooFewEdges = errors.New("Graph must have atleast two edges")
+
+	// ErrNoSimpleGraph is returned when the random models fail to draw a simple
+	// graph within their attempts.
+	ErrNoSimpleGraph = errors.New("Failed to create a simple graph")
+)
+
+// ParameterError is the error about model parameters out of their range,
+// carrying the range required.
+type ParameterError struct {
+
+	// Requires describes the range of the parameters.
+	Requires string
+}
+
+// Error will describe the range required.
+func (err *ParameterError) Error() string {
+	return "Requires " + err.Requires
+}
+
+// Unwrap will return ErrInvalidParameter.
+func (err *ParameterError) Unwrap() error {
+	return ErrInvalidParameter
+}
@@ -0,0 +1,101 @@
+// Package generate builds graphs from random and classic models. Nodes are
+// identified by their number, starting from "0", and edges get the default ids
+// joining their end node ids, smallest first. The random models draw from the
+// given source, a nil source using a fixed seed.
+package generate
+
+import (
+	"math/rand"
+	"sort"
+	"strconv"
+
+	graph "github.com/mkreibe/gograph"
+)
+
+// pair is an undirected edge between two node numbers, smallest first.
+type pair [2]int
+
+// newPair will order the ends of the edge.
+func newPair(u int, v int) pair {
+	if u > v {
+		u, v = v, u
+	}
+	return pair{u, v}
+}
+
+// edgeSet is a set of undirected edges, listed in order with the position of
+// every edge indexed.
+type edgeSet struct {
+	order []pair
+	index map[pair]int
+}
+
+// newEdgeSet will create an empty edge set.
+func newEdgeSet() *edgeSet {
+	return &edgeSet{index: map[pair]int{}}
+}
+
+// add will insert the edge, reporting false if it was already there.
+func (set *edgeSet) add(u int, v int) bool {
+	p := newPair(u, v)
+	if _, ok := set.index[p]; ok {
+		return false
+	}
+
+	set.index[p] = len(set.order)
+	set.order = append(set.order, p)
+	return true
+}
+
+// remove will delete the edge, moving the last edge into its position.
+func (set *edgeSet) remove(u int, v int) {
+	p := newPair(u, v)
+	if i, ok := set.index[p]; ok {
+		last := set.order[len(set.order)-1]
+		set.order[i] = last
+		set.index[last] = i
+		set.order = set.order[:len(set.order)-1]
+		delete(set.index, p)
+	}
+}
+
+// contains will check if the edge is in the set.
+func (set *edgeSet) contains(u int, v int) bool {
+	_, ok := set.index[newPair(u, v)]
+	return ok
+}
+
+// newRandom will draw from the source, or from a fixed seed when it is nil.
+func newRandom(source rand.Source) *rand.Rand {
+	if source == nil {
+		source = rand.NewSource(1)
+	}
+	return rand.New(source)
+}
+
+// build will create the undirected graph of n nodes with the edges, added in
+// ascending order.
+func build(n int, edges []pair) (g *graph.Graph, err error) {
+	if g, err = graph.NewGraph(graph.GraphUndirected); err != nil {
+		return
+	}
+
+	for i := 0; i < n; i++ {
+		if _, err = g.AddNode(strconv.Itoa(i)); err != nil {
+			return
+		}
+	}
+
+	sorted := append([]pair{}, edges...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i][0] < sorted[j][0] || (sorted[i][0] == sorted[j][0] && sorted[i][1] < sorted[j][1])
+	})
+
+	for _, p := range sorted {
+		if _, err = g.AddEdge(graph.NewAttributeCollection(), []string{strconv.Itoa(p[0]), strconv.Itoa(p[1])}); err != nil {
+			return
+		}
+	}
+
+	return
+}
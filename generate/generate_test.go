@@ -0,0 +1,41 @@
+package generate
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEdgeSet(t *testing.T) {
+	edges := newEdgeSet()
+	for _, p := range []pair{{0, 1}, {2, 1}, {2, 3}, {3, 4}} {
+		if !edges.add(p[0], p[1]) {
+			t.Error("Edge already in the set", p)
+		}
+	}
+
+	if edges.add(1, 0) {
+		t.Error("Expected 1-0 to be in the set")
+	}
+
+	edges.remove(2, 1)
+	edges.remove(4, 3)
+	edges.remove(5, 6)
+
+	if !reflect.DeepEqual([]pair{{0, 1}, {2, 3}}, edges.order) {
+		t.Error("Unexpected edges", edges.order)
+	}
+
+	for p, i := range edges.index {
+		if edges.order[i] != p {
+			t.Error("Edge indexed at the wrong position", p)
+		}
+	}
+
+	if edges.contains(1, 2) || !edges.contains(3, 2) {
+		t.Error("Unexpected contents", edges.order)
+	}
+
+	if !edges.add(1, 2) || len(edges.order) != 3 {
+		t.Error("Expected 1-2 to be added again")
+	}
+}
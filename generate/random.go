@@ -0,0 +1,187 @@
+package generate
+
+import (
+	"math/rand"
+
+	graph "github.com/mkreibe/gograph"
+)
+
+// maxRegularTries is the number of pairings tried before a random regular
+// graph gives up.
+const maxRegularTries = 1000
+
+// ErdosRenyi creates a G(n, p) random graph: every pair of the n nodes is
+// linked with probability p.
+func ErdosRenyi(n int, p float64, source rand.Source) (g *graph.Graph, err error) {
+	if n < 0 || p < 0 || p > 1 {
+		return nil, &ParameterError{Requires: "n >= 0 and 0 <= p <= 1"}
+	}
+
+	random := newRandom(source)
+	edges := newEdgeSet()
+	for u := 0; u < n; u++ {
+		for v := u + 1; v < n; v++ {
+			if random.Float64() < p {
+				edges.add(u, v)
+			}
+		}
+	}
+
+	return build(n, edges.order)
+}
+
+// ErdosRenyiM creates a G(n, m) random graph: m distinct edges picked
+// uniformly among the pairs of the n nodes.
+func ErdosRenyiM(n int, m int, source rand.Source) (g *graph.Graph, err error) {
+	if n < 0 || m < 0 || m > n*(n-1)/2 {
+		return nil, &ParameterError{Requires: "n >= 0 and 0 <= m <= n(n-1)/2"}
+	}
+
+	random := newRandom(source)
+	edges := newEdgeSet()
+	for len(edges.order) < m {
+		u, v := random.Intn(n), random.Intn(n)
+		if u != v {
+			edges.add(u, v)
+		}
+	}
+
+	return build(n, edges.order)
+}
+
+// BarabasiAlbert creates a scale free random graph by preferential attachment.
+// Starting from m isolated nodes, every new node links to m distinct existing
+// nodes picked with a probability proportional to their degree.
+func BarabasiAlbert(n int, m int, source rand.Source) (g *graph.Graph, err error) {
+	if m < 1 || m >= n {
+		return nil, &ParameterError{Requires: "1 <= m < n"}
+	}
+
+	random := newRandom(source)
+	edges := newEdgeSet()
+
+	// repeated holds every node once per edge end, so a uniform pick from it
+	// favours the high degree nodes.
+	var repeated []int
+	targets := make([]int, m)
+	for i := range targets {
+		targets[i] = i
+	}
+
+	for u := m; u < n; u++ {
+		for _, v := range targets {
+			edges.add(u, v)
+			repeated = append(repeated, v, u)
+		}
+
+		picked := map[int]bool{}
+		targets = targets[:0]
+		for len(targets) < m {
+			if v := repeated[random.Intn(len(repeated))]; !picked[v] {
+				picked[v] = true
+				targets = append(targets, v)
+			}
+		}
+	}
+
+	return build(n, edges.order)
+}
+
+// WattsStrogatz creates a small world random graph. The n nodes start on a
+// ring, each linked to its k nearest neighbours, and every edge then has its
+// far end rewired to a random node with probability p, avoiding loops and
+// parallel edges.
+func WattsStrogatz(n int, k int, p float64, source rand.Source) (g *graph.Graph, err error) {
+	if k < 2 || k%2 != 0 || k >= n || p < 0 || p > 1 {
+		return nil, &ParameterError{Requires: "an even 2 <= k < n and 0 <= p <= 1"}
+	}
+
+	random := newRandom(source)
+	edges := newEdgeSet()
+	for j := 1; j <= k/2; j++ {
+		for u := 0; u < n; u++ {
+			edges.add(u, (u+j)%n)
+		}
+	}
+
+	degree := make([]int, n)
+	for i := range degree {
+		degree[i] = k
+	}
+
+	for j := 1; j <= k/2; j++ {
+		for u := 0; u < n; u++ {
+			v := (u + j) % n
+			if random.Float64() >= p || degree[u] >= n-1 {
+				continue
+			}
+
+			w := random.Intn(n)
+			for w == u || edges.contains(u, w) {
+				w = random.Intn(n)
+			}
+
+			edges.remove(u, v)
+			edges.add(u, w)
+			degree[v]--
+			degree[w]++
+		}
+	}
+
+	return build(n, edges.order)
+}
+
+// RandomRegular creates a random graph of n nodes which all have degree d, by
+// randomly pairing the edge ends and restarting whenever the pairing gets stuck
+// on a loop or a parallel edge.
+func RandomRegular(d int, n int, source rand.Source) (g *graph.Graph, err error) {
+	if d < 0 || d >= n || (n*d)%2 != 0 {
+		return nil, &ParameterError{Requires: "0 <= d < n and n*d even"}
+	}
+
+	random := newRandom(source)
+	for try := 0; try < maxRegularTries; try++ {
+		if edges, ok := pairRegular(d, n, random); ok {
+			return build(n, edges.order)
+		}
+	}
+
+	return nil, ErrNoSimpleGraph
+}
+
+// pairRegular will try to pair the edge ends into a simple regular graph.
+func pairRegular(d int, n int, random *rand.Rand) (edges *edgeSet, ok bool) {
+	edges = newEdgeSet()
+
+	var stubs []int
+	for u := 0; u < n; u++ {
+		for i := 0; i < d; i++ {
+			stubs = append(stubs, u)
+		}
+	}
+
+	for len(stubs) != 0 {
+		random.Shuffle(len(stubs), func(i, j int) {
+			stubs[i], stubs[j] = stubs[j], stubs[i]
+		})
+
+		var left []int
+		progress := false
+		for i := 0; i+1 < len(stubs); i += 2 {
+			u, v := stubs[i], stubs[i+1]
+			if u != v && !edges.contains(u, v) {
+				edges.add(u, v)
+				progress = true
+			} else {
+				left = append(left, u, v)
+			}
+		}
+
+		if !progress {
+			return nil, false
+		}
+		stubs = left
+	}
+
+	return edges, true
+}
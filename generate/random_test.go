@@ -0,0 +1,147 @@
+package generate
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	graph "github.com/mkreibe/gograph"
+)
+
+func edgeIds(g *graph.Graph) (ids map[string]bool) {
+	ids = map[string]bool{}
+	for id := range g.Edges {
+		ids[id] = true
+	}
+	return
+}
+
+func assertDeterministic(t *testing.T, name string, create func(source rand.Source) (*graph.Graph, error)) (g *graph.Graph) {
+	var err error
+	if g, err = create(rand.NewSource(7)); err != nil {
+		t.Fatal(name, err)
+	}
+
+	other, err := create(rand.NewSource(7))
+	if err != nil {
+		t.Fatal(name, err)
+	}
+
+	if !reflect.DeepEqual(edgeIds(g), edgeIds(other)) {
+		t.Error(name, "is not deterministic for a seed")
+	}
+
+	return
+}
+
+func TestErdosRenyi(t *testing.T) {
+	g := assertDeterministic(t, "G(n,p)", func(source rand.Source) (*graph.Graph, error) {
+		return ErdosRenyi(50, 0.1, source)
+	})
+
+	if len(g.Nodes) != 50 {
+		t.Errorf("Expected 50 nodes, got %d", len(g.Nodes))
+	}
+
+	if g, _ = ErdosRenyi(10, 1, rand.NewSource(1)); len(g.Edges) != 45 {
+		t.Errorf("Expected a complete graph, got %d edges", len(g.Edges))
+	}
+}
+
+func TestErdosRenyiM(t *testing.T) {
+	g := assertDeterministic(t, "G(n,m)", func(source rand.Source) (*graph.Graph, error) {
+		return ErdosRenyiM(30, 60, source)
+	})
+
+	if len(g.Edges) != 60 {
+		t.Errorf("Expected 60 edges, got %d", len(g.Edges))
+	}
+
+	if _, err := ErdosRenyiM(4, 7, rand.NewSource(1)); !errors.Is(err, ErrInvalidParameter) {
+		t.Error("Expected an error, too many edges.")
+	}
+}
+
+func TestBarabasiAlbert(t *testing.T) {
+	g := assertDeterministic(t, "Barabasi-Albert", func(source rand.Source) (*graph.Graph, error) {
+		return BarabasiAlbert(100, 3, source)
+	})
+
+	if len(g.Edges) != 97*3 {
+		t.Errorf("Expected %d edges, got %d", 97*3, len(g.Edges))
+	}
+}
+
+func TestWattsStrogatz(t *testing.T) {
+	g := assertDeterministic(t, "Watts-Strogatz", func(source rand.Source) (*graph.Graph, error) {
+		return WattsStrogatz(40, 4, 0.2, source)
+	})
+
+	if len(g.Edges) != 80 {
+		t.Errorf("Expected 80 edges, got %d", len(g.Edges))
+	}
+
+	if g, _ = WattsStrogatz(10, 2, 0, rand.NewSource(1)); len(g.Nodes["0"].Adj()) != 2 {
+		t.Error("Expected a ring without rewiring.")
+	}
+}
+
+func TestRandomRegular(t *testing.T) {
+	g := assertDeterministic(t, "Random regular", func(source rand.Source) (*graph.Graph, error) {
+		return RandomRegular(3, 20, source)
+	})
+
+	for id, node := range g.Nodes {
+		if len(node.Adj()) != 3 {
+			t.Errorf("Expected node %s to have degree 3, got %d", id, len(node.Adj()))
+		}
+	}
+
+	if _, err := RandomRegular(3, 5, rand.NewSource(1)); !errors.Is(err, ErrInvalidParameter) {
+		t.Error("Expected an error, odd number of edge ends.")
+	}
+}
+
+func TestNilSource(t *testing.T) {
+	for name, create := range map[string]func(source rand.Source) (*graph.Graph, error){
+		"G(n,p)": func(source rand.Source) (*graph.Graph, error) {
+			return ErdosRenyi(20, 0.2, source)
+		},
+		"G(n,m)": func(source rand.Source) (*graph.Graph, error) {
+			return ErdosRenyiM(20, 30, source)
+		},
+		"Barabasi-Albert": func(source rand.Source) (*graph.Graph, error) {
+			return BarabasiAlbert(20, 2, source)
+		},
+		"Watts-Strogatz": func(source rand.Source) (*graph.Graph, error) {
+			return WattsStrogatz(20, 4, 0.3, source)
+		},
+		"random regular": func(source rand.Source) (*graph.Graph, error) {
+			return RandomRegular(3, 10, source)
+		},
+		"configuration model": func(source rand.Source) (*graph.Graph, error) {
+			return ConfigurationModel([]int{2, 2, 2, 1, 1}, false, source)
+		},
+		"double edge swap": func(source rand.Source) (g *graph.Graph, err error) {
+			if g, err = Cycle(8); err == nil {
+				_, err = DoubleEdgeSwap(g, 3, 100, source)
+			}
+			return
+		},
+	} {
+		g, err := create(nil)
+		if err != nil {
+			t.Fatal(name, err)
+		}
+
+		seeded, err := create(rand.NewSource(1))
+		if err != nil {
+			t.Fatal(name, err)
+		}
+
+		if !reflect.DeepEqual(edgeIds(g), edgeIds(seeded)) {
+			t.Error(name, "does not use a fixed seed for a nil source")
+		}
+	}
+}
@@ -1,10 +1,9 @@
 package graph
 
 import (
-	"errors"
-	"fmt"
 	"reflect"
 	"sort"
+	"strconv"
 )
 
 const (
@@ -14,6 +13,14 @@ const (
 
 	// GraphUndirected is an undirected graph.
 	GraphUndirected Type = "undirected"
+
+	// GraphHyper is an undirected hypergraph, where every edge connects any
+	// number of nodes.
+	GraphHyper Type = "hyper"
+
+	// GraphDirectedHyper is a directed hypergraph, where every edge takes a set
+	// of tail nodes to a set of head nodes.
+	GraphDirectedHyper Type = "directed-hyper"
 )
 
 // Type describes the known types of graphs.
@@ -33,6 +40,15 @@ type Graph struct {
 
 	// Nodes contained within this graph.
 	Nodes map[string]*Node
+
+	// Multigraph allows parallel edges. Edges added without an id then get the
+	// default id, followed by '#2', '#3', ... when it is already used, and
+	// adding an edge with an id already used is an error.
+	Multigraph bool
+
+	// AutoCreateNodes adds the nodes an edge connects that are not in the graph
+	// yet, instead of rejecting the edge.
+	AutoCreateNodes bool
 }
 
 // NewGraph will create a new graph.
@@ -46,6 +62,16 @@ func NewGraph(graphType Type) (graph *Graph, err error) {
 	return
 }
 
+// checkKnownType will reject the graph types that are not known.
+func checkKnownType(graph *Graph) (err error) {
+	switch graph.Type {
+	case GraphDirected, GraphUndirected, GraphHyper, GraphDirectedHyper:
+	default:
+		err = &GraphTypeError{Type: graph.Type, Err: ErrUnknownGraphType}
+	}
+	return
+}
+
 // AddNode to the graph.
 func (graph *Graph) AddNode(id string) (node *Node, err error) {
 	if node, err = NewNode(id); err == nil {
@@ -55,26 +81,333 @@ func (graph *Graph) AddNode(id string) (node *Node, err error) {
 	return
 }
 
-// AddEdge will create and attach the edge to the appropriate nodes.
+// AddEdge will create and attach the edge to the appropriate nodes. Unknown
+// node ids are an error, unless the graph creates its nodes automatically.
+// Edges connect atleast two nodes, except in a hypergraph where a hyperedge may
+// hold a single node, or none given an explicit id.
 func (graph *Graph) AddEdge(attrs AttributeCollection, nodeIds []string) (edge *Edge, err error) {
+	created := map[string]*Node{}
+
 	var nodes []*Node
+	if nodes, err = graph.endpoints(nodeIds, created); err != nil {
+		return
+	}
 
-	for _, id := range nodeIds {
-		nodes = append(nodes, graph.Nodes[id])
+	if err = graph.claimEdgeID(attrs, func() string { return defaultEdgeID(nodes) }); err != nil {
+		return
 	}
 
-	if edge, err = NewEdge(attrs, nodes); err == nil {
+	least := 2
+	if graph.Type == GraphHyper {
+		least = 0
+	}
+
+	if edge, err = newEdge(attrs, nodes, least); err == nil {
+		graph.addCreated(created)
 		graph.Edges[edge.ID] = edge
 	}
 
 	return
 }
 
-// HasConnection will check if the connection exists.
+// endpoints will find the nodes of the ids. When the graph creates its nodes
+// automatically the unknown ones are created, but only recorded in created so
+// they can be added once the edge is accepted.
+func (graph *Graph) endpoints(ids []string, created map[string]*Node) (nodes []*Node, err error) {
+	for _, id := range ids {
+		node, ok := graph.Nodes[id]
+		if !ok {
+			if node, ok = created[id]; !ok {
+				if !graph.AutoCreateNodes {
+					return nil, &NodeError{ID: id, Err: ErrNodeNotFound}
+				} else if node, err = NewNode(id); err != nil {
+					return
+				}
+				created[id] = node
+			}
+		}
+		nodes = append(nodes, node)
+	}
+	return
+}
+
+// addCreated will add the automatically created nodes to the graph.
+func (graph *Graph) addCreated(created map[string]*Node) {
+	for id, node := range created {
+		graph.Nodes[id] = node
+	}
+}
+
+// AddHyperedge will create the directed hyperedge from the tail to the head
+// nodes and attach it to them.
+func (graph *Graph) AddHyperedge(attrs AttributeCollection, tailIds []string, headIds []string) (edge *Edge, err error) {
+	if graph.Type != GraphDirectedHyper {
+		return nil, &GraphTypeError{Type: graph.Type, Err: ErrNotDirectedHypergraph}
+	}
+
+	created := map[string]*Node{}
+
+	var tail, head []*Node
+	if tail, err = graph.endpoints(tailIds, created); err != nil {
+		return
+	} else if head, err = graph.endpoints(headIds, created); err != nil {
+		return
+	}
+
+	if err = graph.claimEdgeID(attrs, func() string {
+		return defaultHyperedgeID(distinctNodes(tail), distinctNodes(head))
+	}); err != nil {
+		return
+	}
+
+	if edge, err = NewHyperedge(attrs, tail, head); err == nil {
+		graph.addCreated(created)
+		graph.Edges[edge.ID] = edge
+	}
+
+	return
+}
+
+// RemoveEdge will detach the edge from its nodes and remove it from the graph.
+// The removed edge keeps its nodes so it can still be inspected.
+func (graph *Graph) RemoveEdge(id string) (edge *Edge, err error) {
+	var ok bool
+	if edge, ok = graph.Edges[id]; !ok {
+		return nil, &EdgeError{ID: id, Err: ErrEdgeNotFound}
+	}
+
+	delete(graph.Edges, id)
+	for _, node := range edge.Nodes {
+		delete(node.Edges, id)
+	}
+
+	return
+}
+
+// RemoveNode will remove the node from the graph, along with every edge it is
+// part of. The removed edges are returned in the order of their ids.
+func (graph *Graph) RemoveNode(id string) (node *Node, edges []*Edge, err error) {
+	var ok bool
+	if node, ok = graph.Nodes[id]; !ok {
+		return nil, nil, &NodeError{ID: id, Err: ErrNodeNotFound}
+	}
+
+	edges = graph.removeIncident([]*Node{node})
+	delete(graph.Nodes, id)
+
+	return
+}
+
+// RemoveEdges will remove every edge the predicate holds for, returning them in
+// the order of their ids. A nil predicate holds for no edge.
+func (graph *Graph) RemoveEdges(predicate func(edge *Edge) bool) (edges []*Edge) {
+	if predicate == nil {
+		return
+	}
+
+	for _, edge := range sortedEdges(graph) {
+		if predicate(edge) {
+			graph.RemoveEdge(edge.ID)
+			edges = append(edges, edge)
+		}
+	}
+	return
+}
+
+// RemoveNodes will remove every node the predicate holds for, along with every
+// edge they are part of. The removed nodes and edges are returned in the order
+// of their ids. A nil predicate holds for no node.
+func (graph *Graph) RemoveNodes(predicate func(node *Node) bool) (nodes []*Node, edges []*Edge) {
+	if predicate == nil {
+		return
+	}
+
+	for _, id := range graph.NodeOrder() {
+		if node := graph.Nodes[id]; predicate(node) {
+			nodes = append(nodes, node)
+		}
+	}
+
+	edges = graph.removeIncident(nodes)
+	for _, node := range nodes {
+		delete(graph.Nodes, node.ID)
+	}
+
+	return
+}
+
+// removeIncident will remove the edges the nodes are part of, returning them in
+// the order of their ids.
+func (graph *Graph) removeIncident(nodes []*Node) (edges []*Edge) {
+	incident := map[string]*Edge{}
+	for _, node := range nodes {
+		for id, edge := range node.Edges {
+			incident[id] = edge
+		}
+	}
+
+	for _, edge := range incident {
+		edges = append(edges, edge)
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		return edges[i].ID < edges[j].ID
+	})
+
+	for _, edge := range edges {
+		graph.RemoveEdge(edge.ID)
+	}
+
+	return
+}
+
+// claimEdgeID will pick the id of the edge to add to a multigraph, so it never
+// replaces another edge. Other graphs are left alone.
+func (graph *Graph) claimEdgeID(attrs AttributeCollection, defaultID func() string) (err error) {
+	if !graph.Multigraph {
+		return
+	}
+
+	if value, ok := attrs.Get("id"); ok {
+		if id, _ := value.(string); graph.Edges[id] != nil {
+			err = &EdgeError{ID: id, Err: ErrDuplicateEdgeID}
+		}
+		return
+	}
+
+	attrs.Set("id", graph.freeEdgeID(defaultID()))
+	return
+}
+
+// freeEdgeID will return the id, followed by '#2', '#3', ... when it is already
+// used by an edge of the graph.
+func (graph *Graph) freeEdgeID(base string) (id string) {
+	id = base
+	for n := 2; graph.Edges[id] != nil; n++ {
+		id = base + "#" + strconv.Itoa(n)
+	}
+	return
+}
+
+// EdgesBetween finds the edges joining the nodes, in the order of their ids.
+// For directed graphs and directed hypergraphs these are the edges leading from
+// the first node to the second. A node is only joined to itself by a loop.
+func (graph *Graph) EdgesBetween(first string, second string) (edges []*Edge, err error) {
+	node, ok := graph.Nodes[first]
+	if !ok {
+		return nil, &NodeError{ID: first, Err: ErrNodeNotFound}
+	} else if _, ok = graph.Nodes[second]; !ok {
+		return nil, &NodeError{ID: second, Err: ErrNodeNotFound}
+	}
+
+	directed := graph.Type == GraphDirected || graph.Type == GraphDirectedHyper
+	for _, edge := range node.Edges {
+		if directed {
+			tail, head := edge.Ends()
+			if containsNode(tail, first) && containsNode(head, second) {
+				edges = append(edges, edge)
+			}
+		} else if _, ok := edge.Nodes[second]; ok && (first != second || edge.IsLoop()) {
+			edges = append(edges, edge)
+		}
+	}
+
+	sort.Slice(edges, func(i, j int) bool {
+		return edges[i].ID < edges[j].ID
+	})
+
+	return
+}
+
+// containsNode will check if the node id is among the nodes.
+func containsNode(nodes []*Node, id string) bool {
+	for _, node := range nodes {
+		if node.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Subgraph will create the subgraph induced by the node ids. The nodes and the
+// edges connecting only those nodes keep their ids and a copy of their attributes.
+// The subgraph of a multigraph is a multigraph.
+func (graph *Graph) Subgraph(nodeIds []string) (subgraph *Graph, err error) {
+	if subgraph, err = NewGraph(graph.Type); err != nil {
+		return
+	}
+	subgraph.Attributes = graph.Attributes.Copy()
+	subgraph.Multigraph = graph.Multigraph
+
+	for _, id := range nodeIds {
+		if original, ok := graph.Nodes[id]; !ok {
+			err = &NodeError{ID: id, Err: ErrNodeNotFound}
+			return
+		} else if _, ok := subgraph.Nodes[id]; !ok {
+			var node *Node
+			if node, err = subgraph.AddNode(id); err != nil {
+				return
+			}
+			node.Attributes = original.Attributes.Copy()
+		}
+	}
+
+	for _, edge := range sortedEdges(graph) {
+		inside := true
+		for _, node := range edge.Order {
+			_, ok := subgraph.Nodes[node.ID]
+			inside = inside && ok
+		}
+
+		if inside {
+			if _, err = subgraph.copyEdge(edge); err != nil {
+				return
+			}
+		}
+	}
+
+	return
+}
+
+// copyEdge will add a copy of the edge, from another graph, to this graph. The
+// copy keeps the id and a copy of the attributes.
+func (graph *Graph) copyEdge(edge *Edge) (copied *Edge, err error) {
+	attrs := edge.Attributes.Copy()
+	attrs.Set("id", edge.ID)
+
+	if edge.Tail != nil {
+		copied, err = graph.AddHyperedge(attrs, idsOf(edge.Tail), idsOf(edge.Head))
+	} else {
+		copied, err = graph.AddEdge(attrs, idsOf(edge.Order))
+	}
+	return
+}
+
+// idsOf will list the ids of the nodes.
+func idsOf(nodes []*Node) (ids []string) {
+	for _, node := range nodes {
+		ids = append(ids, node.ID)
+	}
+	return
+}
+
+// NodeOrder is the stable order of the nodes used by the algorithms and the
+// matrices: their ids in ascending order.
+func (graph *Graph) NodeOrder() (order []string) {
+	for id := range graph.Nodes {
+		order = append(order, id)
+	}
+	sort.Strings(order)
+	return
+}
+
+// HasConnection will check if the connection exists. In a hypergraph two nodes
+// are connected when they share a hyperedge. In a directed graph, or a directed
+// hypergraph, the source must be in the tail and the target in the head of an
+// edge.
 func (graph *Graph) HasConnection(source string, target string) (result bool, err error) {
 
 	switch graph.Type {
-	case GraphUndirected:
+	case GraphUndirected, GraphHyper:
 		{
 			for _, edge := range graph.Edges {
 
@@ -103,8 +436,19 @@ func (graph *Graph) HasConnection(source string, target string) (result bool, er
 			}
 			break
 		}
+	case GraphDirected, GraphDirectedHyper:
+		{
+			for _, edge := range graph.Edges {
+				tail, head := edge.Ends()
+				if containsNode(tail, source) && containsNode(head, target) {
+					result = true
+					break
+				}
+			}
+			break
+		}
 	default:
-		err = fmt.Errorf("Unknown graph type: %s", graph.Type)
+		err = &GraphTypeError{Type: graph.Type, Err: ErrUnknownGraphType}
 	}
 	return
 }
@@ -113,7 +457,7 @@ func (graph *Graph) HasConnection(source string, target string) (result bool, er
 func (graph *Graph) IterNodes(attr string, iterFunc func(node *Node) (cont bool, err error)) (err error) {
 
 	if iterFunc == nil {
-		err = errors.New("No iteration function")
+		err = ErrNoIterationFunc
 	} else {
 		nodeMap := map[interface{}][]*Node{}
 		for _, node := range graph.Nodes {
@@ -141,7 +485,7 @@ func (graph *Graph) IterNodes(attr string, iterFunc func(node *Node) (cont bool,
 				}
 				attrsValues = append(attrsValues.(sort.Float64Slice), key.(float64))
 			default:
-				err = errors.New("Non sortable type. Requires string, int or float64")
+				err = ErrNotSortable
 				break
 			}
 		}
@@ -13,6 +13,14 @@ func printError(t *testing.T, testName string, expected interface{}, actual inte
 	)
 }
 
+func LoadTestGraph(t *testing.T, fileName string) (graph *Graph) {
+	var err error
+	if graph, err = LoadFileGraph(fileName); err != nil {
+		t.Fatal(err)
+	}
+	return
+}
+
 func AssertWithCheckFunc(t *testing.T, testName string, expected interface{}, actual interface{}, checker func(e interface{}, a interface{}) bool) bool {
 	if !checker(expected, actual) {
 		printError(t, testName, actual, expected)
@@ -95,7 +103,7 @@ func AssertNodes(t *testing.T, testName string, expected []*Node, actual map[str
 func AssertAreConnected(t *testing.T, graph *Graph, source string, target string, expected bool) (result bool) {
 
 	symbol := "-"
-	if graph.Type == GraphDirected {
+	if graph.Type == GraphDirected || graph.Type == GraphDirectedHyper {
 		symbol = "->"
 	}
 
@@ -0,0 +1,291 @@
+package graph
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestHypergraphLoading(t *testing.T) {
+	graph := LoadTestGraph(t, "./data/h_7n_4e.json")
+
+	AssertT(t, "Type", GraphHyper, graph.Type)
+	AssertT(t, "Nodes", 7, len(graph.Nodes))
+	AssertT(t, "Edges", 4, len(graph.Edges))
+
+	AssertAreConnected(t, graph, "1", "3", true)
+	AssertAreConnected(t, graph, "3", "6", true)
+	AssertAreConnected(t, graph, "1", "4", false)
+	AssertAreConnected(t, graph, "5", "7", false)
+}
+
+func TestIncidenceMatrix(t *testing.T) {
+	graph := LoadTestGraph(t, "./data/h_7n_4e.json")
+
+	nodes, edges, matrix, err := graph.IncidenceMatrix()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	AssertT(t, "Node order", true, reflect.DeepEqual([]string{"1", "2", "3", "4", "5", "6", "7"}, nodes))
+	AssertT(t, "Edge order", true, reflect.DeepEqual([]string{"a", "b", "c", "d"}, edges))
+
+	expected := [][]float64{
+		{1, 0, 0, 0},
+		{1, 1, 0, 0},
+		{1, 1, 1, 0},
+		{0, 1, 1, 0},
+		{0, 0, 1, 0},
+		{0, 0, 1, 1},
+		{0, 0, 0, 1},
+	}
+	AssertT(t, "Incidence", true, reflect.DeepEqual(expected, matrix))
+
+	directed := buildGraph(t, GraphDirected, []string{"x", "y"}, [][]string{{"y", "x"}})
+	if _, _, matrix, err = directed.IncidenceMatrix(); err != nil {
+		t.Fatal(err)
+	}
+	AssertT(t, "Directed incidence", true, reflect.DeepEqual([][]float64{{1}, {-1}}, matrix))
+}
+
+func TestHypergraphDegrees(t *testing.T) {
+	graph := LoadTestGraph(t, "./data/h_7n_4e.json")
+
+	nodes, err := graph.NodeDegrees()
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertT(t, "Node degrees", true, reflect.DeepEqual(map[string]int{
+		"1": 1, "2": 2, "3": 3, "4": 2, "5": 1, "6": 2, "7": 1,
+	}, nodes))
+
+	edges, err := graph.EdgeDegrees()
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertT(t, "Edge degrees", true, reflect.DeepEqual(map[string]int{"a": 3, "b": 3, "c": 4, "d": 2}, edges))
+
+	directed := buildGraph(t, GraphDirected, []string{"x", "y"}, [][]string{{"x", "y"}})
+	if _, err = directed.NodeDegrees(); !errors.Is(err, ErrNotUndirected) {
+		t.Error("Expected an error for a directed graph")
+	}
+}
+
+func TestSDistances(t *testing.T) {
+	graph := LoadTestGraph(t, "./data/h_7n_4e.json")
+
+	distances, err := graph.SDistances("a", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertT(t, "1-distances", true, reflect.DeepEqual(map[string]int{"a": 0, "b": 1, "c": 1, "d": 2}, distances))
+
+	if distances, err = graph.SDistances("a", 2); err != nil {
+		t.Fatal(err)
+	}
+	AssertT(t, "2-distances", true, reflect.DeepEqual(map[string]int{"a": 0, "b": 1, "c": 2}, distances))
+
+	if _, err = graph.SDistances("d", 3); err == nil {
+		t.Error("Expected an error for a hyperedge smaller than s")
+	}
+
+	if _, err = graph.SDistances("z", 1); err == nil {
+		t.Error("Expected an error for an unknown hyperedge")
+	}
+
+	if _, err = graph.SDistances("a", 0); err == nil {
+		t.Error("Expected an error for s of 0")
+	}
+}
+
+func TestSComponents(t *testing.T) {
+	graph := LoadTestGraph(t, "./data/h_7n_4e.json")
+
+	for s, expected := range map[int][][]string{
+		1: {{"a", "b", "c", "d"}},
+		2: {{"a", "b", "c"}, {"d"}},
+		3: {{"a"}, {"b"}, {"c"}},
+		5: nil,
+	} {
+		components, err := graph.SComponents(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(expected, components) {
+			t.Errorf("Expected %d-components %v, got %v", s, expected, components)
+		}
+	}
+}
+
+func TestDual(t *testing.T) {
+	graph := LoadTestGraph(t, "./data/h_7n_4e.json")
+
+	dual, err := graph.Dual()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	AssertT(t, "Type", GraphHyper, dual.Type)
+	AssertT(t, "Nodes", 4, len(dual.Nodes))
+	AssertT(t, "Edges", 7, len(dual.Edges))
+
+	style, _ := dual.Nodes["a"].Attributes.Get("style")
+	AssertT(t, "Node attributes", "dashed", style)
+
+	for id, members := range map[string][]string{
+		"2": {"a", "b"},
+		"3": {"a", "b", "c"},
+		"4": {"b", "c"},
+		"6": {"c", "d"},
+		"1": {"a"},
+		"5": {"c"},
+		"7": {"d"},
+	} {
+		if edge, ok := dual.Edges[id]; !ok {
+			t.Error("Missing dual hyperedge", id)
+		} else {
+			AssertT(t, "Dual hyperedge "+id, len(members), len(edge.Nodes))
+			for _, member := range members {
+				if _, ok := edge.Nodes[member]; !ok {
+					t.Errorf("Dual hyperedge %s misses %s", id, member)
+				}
+			}
+		}
+	}
+	AssertT(t, "Single node hyperedge", false, dual.Edges["1"].IsLoop())
+
+	if _, err := graph.AddNode("8"); err != nil {
+		t.Fatal(err)
+	} else if dual, err = graph.Dual(); err != nil {
+		t.Fatal(err)
+	}
+	AssertT(t, "Empty hyperedge", 0, len(dual.Edges["8"].Nodes))
+
+	// the incidence matrix of the dual is the transpose of the original one.
+	nodes, edges, matrix, _ := graph.IncidenceMatrix()
+	dualNodes, dualEdges, dualMatrix, _ := dual.IncidenceMatrix()
+	AssertT(t, "Dual nodes", true, reflect.DeepEqual(edges, dualNodes))
+	AssertT(t, "Dual edges", true, reflect.DeepEqual(nodes, dualEdges))
+	for i := range matrix {
+		for j := range matrix[i] {
+			AssertT(t, "Dual incidence", matrix[i][j], dualMatrix[j][i])
+		}
+	}
+}
+
+func TestSmallHyperedges(t *testing.T) {
+	graph := buildGraph(t, GraphHyper, []string{"a", "b"}, [][]string{{"a"}, {"a", "b"}})
+	AssertT(t, "Single node hyperedge", false, graph.Edges["a"].IsLoop())
+	AssertT(t, "Degree", 2, graph.Nodes["a"].Degree())
+	AssertT(t, "Adjacent", 1, len(graph.Nodes["a"].Adj()))
+
+	if _, err := graph.AddEdge(NewAttributeCollection(), nil); !errors.Is(err, ErrInvalidEdgeID) {
+		printError(t, "Empty hyperedge without id", ErrInvalidEdgeID, err)
+	} else if _, err = graph.AddEdge(BuildAttributes(map[string]interface{}{"id": "none"}), nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := buildGraph(t, GraphUndirected, []string{"a"}, nil).AddEdge(NewAttributeCollection(), []string{"a"}); !errors.Is(err, ErrTooFewEndpoints) {
+		printError(t, "Single node edge", ErrTooFewEndpoints, err)
+	}
+
+	_, laplacian, err := graph.LaplacianMatrix("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertT(t, "Laplacian", true, reflect.DeepEqual([][]float64{{1, -1}, {-1, 1}}, laplacian))
+}
+
+func TestCliqueExpansion(t *testing.T) {
+	graph := LoadTestGraph(t, "./data/h_7n_4e.json")
+
+	expansion, err := graph.CliqueExpansion()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	AssertT(t, "Type", GraphUndirected, expansion.Type)
+	AssertT(t, "Nodes", 7, len(expansion.Nodes))
+	AssertT(t, "Edges", 11, len(expansion.Edges))
+
+	color, _ := expansion.Nodes["1"].Attributes.Get("color")
+	AssertT(t, "Node attributes", "green", color)
+
+	for id, expected := range map[string]int{"2-3": 2, "3-4": 2, "1-2": 1, "6-7": 1} {
+		if edge, ok := expansion.Edges[id]; !ok {
+			t.Error("Missing edge", id)
+		} else {
+			weight, _ := edge.Attributes.Get("weight")
+			AssertT(t, "Weight "+id, expected, weight)
+		}
+	}
+}
+
+func TestCliqueExpansionIds(t *testing.T) {
+	graph := buildGraph(t, GraphHyper, []string{"a", "a-b", "c", "b-c"}, nil)
+	for i, ends := range [][]string{{"a-b", "c"}, {"a", "b-c"}} {
+		attrs := NewAttributeCollection()
+		attrs.Set("id", "e"+strconv.Itoa(i))
+		if _, err := graph.AddEdge(attrs, ends); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expansion, err := graph.CliqueExpansion()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	AssertT(t, "Edges", 2, len(expansion.Edges))
+	for id, edge := range expansion.Edges {
+		for _, node := range edge.Order {
+			AssertT(t, "Edge "+id+" of node "+node.ID, edge, node.Edges[id])
+		}
+	}
+}
+
+func TestStarExpansion(t *testing.T) {
+	graph := LoadTestGraph(t, "./data/h_7n_4e.json")
+
+	expansion, err := graph.StarExpansion()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	AssertT(t, "Type", GraphUndirected, expansion.Type)
+	AssertT(t, "Nodes", 11, len(expansion.Nodes))
+	AssertT(t, "Edges", 12, len(expansion.Edges))
+
+	side, _ := expansion.Nodes["3"].Attributes.Get("bipartite")
+	AssertT(t, "Node side", 0, side)
+	side, _ = expansion.Nodes["c"].Attributes.Get("bipartite")
+	AssertT(t, "Hyperedge side", 1, side)
+	AssertT(t, "Hyperedge degree", 4, len(expansion.Nodes["c"].Edges))
+
+	if _, ok := expansion.Edges["6-d"]; !ok {
+		t.Error("Missing edge 6-d")
+	}
+
+	clash := buildGraph(t, GraphHyper, []string{"a", "b"}, nil)
+	if _, err = clash.AddEdge(BuildAttributes(map[string]interface{}{"id": "a"}), []string{"a", "b"}); err != nil {
+		t.Fatal(err)
+	} else if _, err = clash.StarExpansion(); err == nil {
+		t.Error("Expected an error for a hyperedge id clashing with a node id")
+	}
+
+	// the edges a-b-c, of node a-b in hyperedge c and of node a in hyperedge b-c.
+	dashed := buildGraph(t, GraphHyper, []string{"a", "a-b", "x"}, nil)
+	if _, err = dashed.AddEdge(BuildAttributes(map[string]interface{}{"id": "c"}), []string{"a-b", "x"}); err != nil {
+		t.Fatal(err)
+	} else if _, err = dashed.AddEdge(BuildAttributes(map[string]interface{}{"id": "b-c"}), []string{"a", "x"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if expansion, err = dashed.StarExpansion(); err != nil {
+		t.Fatal(err)
+	}
+	AssertT(t, "Dashed edges", 4, len(expansion.Edges))
+	AssertT(t, "First a-b-c", true, reflect.DeepEqual([]string{"a", "b-c"}, idsOf(expansion.Edges["a-b-c"].Order)))
+	AssertT(t, "Second a-b-c", true, reflect.DeepEqual([]string{"a-b", "c"}, idsOf(expansion.Edges["a-b-c#2"].Order)))
+}
@@ -0,0 +1,140 @@
+package graph
+
+import (
+	"testing"
+)
+
+func buildGraph(t *testing.T, graphType Type, nodes []string, edges [][]string) (graph *Graph) {
+	graph, _ = NewGraph(graphType)
+	for _, id := range nodes {
+		if _, err := graph.AddNode(id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, ends := range edges {
+		if _, err := graph.AddEdge(NewAttributeCollection(), ends); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return
+}
+
+func TestIsIsomorphic(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/ud_5n_7e.json"); err != nil {
+		t.Error(err)
+	} else {
+		other := buildGraph(t, GraphUndirected, []string{"a", "b", "c", "d", "e"}, [][]string{
+			{"c", "a"}, {"c", "e"}, {"c", "b"}, {"c", "d"}, {"a", "b"}, {"b", "d"}, {"d", "e"},
+		})
+
+		isomorphic, mapping, err := graph.IsIsomorphic(other, nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		AssertT(t, "Isomorphic", true, isomorphic)
+		AssertT(t, "Hub mapping", "c", mapping["2"])
+
+		other.Nodes["a"].Attributes.Set("color", "green")
+		options := &MatchOptions{NodeMatch: MatchAttributes("color")}
+		if isomorphic, mapping, err = graph.IsIsomorphic(other, options); err == nil {
+			AssertT(t, "Colored isomorphic", true, isomorphic)
+			AssertT(t, "Colored mapping", "a", mapping["1"])
+		} else {
+			t.Error(err)
+		}
+
+		other.Nodes["c"].Attributes.Set("color", "green")
+		other.Nodes["a"].Attributes.Remove("color")
+		if isomorphic, _, err = graph.IsIsomorphic(other, options); err == nil {
+			AssertT(t, "Colored mismatch", false, isomorphic)
+		} else {
+			t.Error(err)
+		}
+	}
+}
+
+func TestNotIsomorphic(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/ud_5n_7e.json"); err != nil {
+		t.Error(err)
+	} else {
+		other := buildGraph(t, GraphUndirected, []string{"a", "b", "c", "d", "e"}, [][]string{
+			{"a", "b"}, {"a", "c"}, {"a", "d"}, {"b", "c"}, {"b", "d"}, {"c", "d"}, {"d", "e"},
+		})
+
+		if isomorphic, _, err := graph.IsIsomorphic(other, nil); err == nil {
+			AssertT(t, "Isomorphic", false, isomorphic)
+		} else {
+			t.Error(err)
+		}
+	}
+}
+
+func TestSubgraphIsomorphisms(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/ud_5n_7e.json"); err != nil {
+		t.Error(err)
+	} else {
+		triangle := buildGraph(t, GraphUndirected, []string{"x", "y", "z"}, [][]string{{"x", "y"}, {"y", "z"}, {"x", "z"}})
+
+		count := 0
+		if err = graph.SubgraphIsomorphisms(triangle, nil, func(mapping map[string]string) (cont bool, err error) {
+			count++
+			return true, nil
+		}); err != nil {
+			t.Error(err)
+		}
+		AssertT(t, "Triangles", 18, count)
+
+		triangle.Nodes["x"].Attributes.Set("color", "green")
+		count = 0
+		if err = graph.SubgraphIsomorphisms(triangle, &MatchOptions{NodeMatch: MatchAttributes("color")}, func(mapping map[string]string) (cont bool, err error) {
+			AssertT(t, "Green node", "1", mapping["x"])
+			count++
+			return true, nil
+		}); err != nil {
+			t.Error(err)
+		}
+		AssertT(t, "Green triangles", 2, count)
+
+		// an induced path may not close into a triangle.
+		path := buildGraph(t, GraphUndirected, []string{"x", "y", "z"}, [][]string{{"x", "y"}, {"y", "z"}})
+		count = 0
+		graph.SubgraphIsomorphisms(path, nil, func(mapping map[string]string) (cont bool, err error) {
+			count++
+			return true, nil
+		})
+		AssertT(t, "Induced paths", 10, count)
+	}
+}
+
+func TestSubgraphIsomorphismsDirected(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/d_4n_5e.json"); err != nil {
+		t.Error(err)
+	} else {
+		cycle := buildGraph(t, GraphDirected, []string{"x", "y"}, [][]string{{"x", "y"}, {"y", "x"}})
+		count := 0
+		graph.SubgraphIsomorphisms(cycle, nil, func(mapping map[string]string) (cont bool, err error) {
+			count++
+			return true, nil
+		})
+		AssertT(t, "Two cycles", 2, count)
+	}
+}
+
+func TestSubgraphIsomorphismsEdgeMatch(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/ud_5n_7e.json"); err != nil {
+		t.Error(err)
+	} else {
+		pair := buildGraph(t, GraphUndirected, []string{"x", "y"}, [][]string{{"x", "y"}})
+		pair.Edges["x-y"].Attributes.Set("style", "dashed")
+
+		count := 0
+		graph.SubgraphIsomorphisms(pair, &MatchOptions{EdgeMatch: MatchAttributes("style")}, func(mapping map[string]string) (cont bool, err error) {
+			AssertT(t, "Dashed edge", true, mapping["x"] == "1" || mapping["x"] == "2")
+			count++
+			return true, nil
+		})
+		AssertT(t, "Dashed edges", 2, count)
+	}
+}
@@ -8,18 +8,22 @@ import (
 // jsonNode is the json representation for the node.
 type jsonNode map[string]interface{}
 
-// jsonEdge os the json connections.
+// jsonEdge os the json connections. Directed hyperedges hold two lists of node
+// ids, the tail and the head, instead of the node ids.
 type jsonEdge []interface{}
 
 // jsonGraphRepresentation defines the graph representation.
 type jsonGraphRepresentation struct {
 	Type       Type              `json:"type"`
+	Multigraph bool              `json:"multigraph"`
+	AutoCreate bool              `json:"autocreate"`
 	Attributes map[string]string `json:"attributes"`
 	Nodes      []jsonNode        `json:"nodes"`
 	Edges      []jsonEdge        `json:"edges"`
 }
 
-// LoadFileGraph will load the graph from a file.
+// LoadFileGraph will load the graph from a file. Edges naming a node that is not
+// listed are an error, unless 'autocreate' is set and the node is added.
 func LoadFileGraph(fileName string) (graph *Graph, err error) {
 
 	var file []byte
@@ -27,6 +31,8 @@ func LoadFileGraph(fileName string) (graph *Graph, err error) {
 		jsonGraph := jsonGraphRepresentation{}
 		if err = json.Unmarshal(file, &jsonGraph); err == nil {
 			if graph, err = NewGraph(jsonGraph.Type); err == nil {
+				graph.Multigraph = jsonGraph.Multigraph
+				graph.AutoCreateNodes = jsonGraph.AutoCreate
 
 				// 1 - Load the attributes
 				for key, value := range jsonGraph.Attributes {
@@ -39,8 +45,14 @@ func LoadFileGraph(fileName string) (graph *Graph, err error) {
 					nid := n["id"]
 
 					if nid != nil {
+						id, ok := nid.(string)
+						if !ok {
+							err = &NodeError{Err: ErrInvalidNodeID}
+							break
+						}
+
 						var node *Node
-						if node, err = graph.AddNode(nid.(string)); err != nil {
+						if node, err = graph.AddNode(id); err != nil {
 							break
 						}
 
@@ -57,18 +69,42 @@ func LoadFileGraph(fileName string) (graph *Graph, err error) {
 					for _, edge := range jsonGraph.Edges {
 
 						var nodes []string
+						var sets [][]string
+						invalid := false
 						attr := NewAttributeCollection()
 
 						for _, item := range edge {
 							switch item.(type) {
 							case string:
 								nodes = append(nodes, item.(string))
+							case []interface{}:
+								var set []string
+								for _, id := range item.([]interface{}) {
+									if str, ok := id.(string); ok {
+										set = append(set, str)
+									} else {
+										invalid = true
+									}
+								}
+								sets = append(sets, set)
 							case map[string]interface{}:
 								attr.Merge(item.(map[string]interface{}), true)
+							default:
+								invalid = true
 							}
 						}
 
-						if _, err = graph.AddEdge(attr, nodes); err != nil {
+						if invalid {
+							err = &EdgeError{ID: explicitID(attr), Err: ErrInvalidNodeID}
+						} else if len(sets) == 0 {
+							_, err = graph.AddEdge(attr, nodes)
+						} else if len(sets) == 2 && len(nodes) == 0 {
+							_, err = graph.AddHyperedge(attr, sets[0], sets[1])
+						} else {
+							err = &EdgeError{ID: explicitID(attr), Err: ErrHyperedgeSets}
+						}
+
+						if err != nil {
 							break
 						}
 					}
@@ -0,0 +1,66 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestKShortestPaths(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/d_yen_6n_9e.json"); err != nil {
+		t.Error(err)
+	} else {
+		paths, err := graph.KShortestPaths("C", "H", "weight")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		expected := []Path{
+			{Nodes: []string{"C", "E", "F", "H"}, Edges: []string{"C-E", "E-F", "F-H"}, Cost: 5},
+			{Nodes: []string{"C", "E", "G", "H"}, Edges: []string{"C-E", "E-G", "G-H"}, Cost: 7},
+			{Nodes: []string{"C", "D", "F", "H"}, Edges: []string{"C-D", "D-F", "F-H"}, Cost: 8},
+			{Nodes: []string{"C", "E", "D", "F", "H"}, Edges: []string{"C-E", "E-D", "D-F", "F-H"}, Cost: 8},
+		}
+
+		for i, e := range expected {
+			if path, ok := paths.Next(); ok {
+				AssertWithCheckFunc(t, "Path", e, *path, reflect.DeepEqual)
+			} else {
+				t.Errorf("Expected path %d", i)
+			}
+		}
+
+		count := len(expected)
+		for _, ok := paths.Next(); ok; _, ok = paths.Next() {
+			count++
+		}
+		AssertT(t, "All paths", 7, count)
+	}
+}
+
+func TestKShortestPathsUndirected(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/ud_6n_7e.json"); err != nil {
+		t.Error(err)
+	} else {
+		paths, err := graph.KShortestPaths("a", "f", "")
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		count := 0
+		for path, ok := paths.Next(); ok; path, ok = paths.Next() {
+			AssertT(t, "Bridge", true, len(path.Nodes) >= 4)
+			count++
+		}
+		AssertT(t, "Undirected paths", 4, count)
+	}
+}
+
+func TestKShortestPathsUnknownNode(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/d_yen_6n_9e.json"); err != nil {
+		t.Error(err)
+	} else if _, err = graph.KShortestPaths("C", "Z", ""); err == nil {
+		t.Error("Expected an error, unknown node.")
+	}
+}
@@ -8,20 +8,29 @@ import (
 func TestBadGraphType(t *testing.T) {
 	graph := Graph{Type: "foo"}
 	if _, err := graph.HasConnection("first", "second"); err != nil {
-		errMsg := "Unknown graph type: foo"
-		if err.Error() != errMsg {
-			printError(t, "Bad graph type", errMsg, err.Error())
+		var typeErr *GraphTypeError
+		if !errors.Is(err, ErrUnknownGraphType) || !errors.As(err, &typeErr) {
+			printError(t, "Bad graph type", ErrUnknownGraphType, err)
+		} else {
+			AssertT(t, "Bad graph type", Type("foo"), typeErr.Type)
+			AssertT(t, "Bad graph type message", "Unknown graph type: foo", err.Error())
 		}
 	} else {
 		t.Error("Expected an error, invalid type.")
 	}
+
+	// a graph of an unknown type is created, only using it fails.
+	if graph, err := NewGraph("foo"); err != nil {
+		t.Error(err)
+	} else if _, err = graph.HasConnection("first", "second"); !errors.Is(err, ErrUnknownGraphType) {
+		t.Error("Expected an error, invalid type.")
+	}
 }
 
 func TestBadNodeId(t *testing.T) {
 	if _, err := LoadFileGraph("./data/e_nonodeid.json"); err != nil {
-		errMsg := "Node must have a valid id"
-		if err.Error() != errMsg {
-			printError(t, "Bad node id", errMsg, err.Error())
+		if !errors.Is(err, ErrInvalidNodeID) {
+			printError(t, "Bad node id", ErrInvalidNodeID, err)
 		}
 	} else {
 		t.Error("Expected an error, invalid node id.")
@@ -30,9 +39,8 @@ func TestBadNodeId(t *testing.T) {
 
 func TestBadEdgeId(t *testing.T) {
 	if _, err := LoadFileGraph("./data/e_noedgeid.json"); err != nil {
-		errMsg := "Invalid edge id"
-		if err.Error() != errMsg {
-			printError(t, "Bad edge id", errMsg, err.Error())
+		if !errors.Is(err, ErrInvalidEdgeID) {
+			printError(t, "Bad edge id", ErrInvalidEdgeID, err)
 		}
 	} else {
 		t.Error("Expected an error, invalid edge id.")
@@ -41,9 +49,11 @@ func TestBadEdgeId(t *testing.T) {
 
 func TestBadEdgeConnectionsNode(t *testing.T) {
 	if _, err := LoadFileGraph("./data/e_noedgeconnections.json"); err != nil {
-		errMsg := "Edges must have atleast two nodes to connect"
-		if err.Error() != errMsg {
-			printError(t, "Bad edge connection", errMsg, err.Error())
+		var edgeErr *EdgeError
+		if !errors.Is(err, ErrTooFewEndpoints) || !errors.As(err, &edgeErr) {
+			printError(t, "Bad edge connection", ErrTooFewEndpoints, err)
+		} else {
+			AssertT(t, "Bad edge connection id", "a", edgeErr.ID)
 		}
 	} else {
 		t.Error("Expected an error, invalid edge connections (none).")
@@ -52,15 +62,80 @@ func TestBadEdgeConnectionsNode(t *testing.T) {
 
 func TestBadEdgeConnectionsSingular(t *testing.T) {
 	if _, err := LoadFileGraph("./data/e_singleedgeconnection.json"); err != nil {
-		errMsg := "Edges must have atleast two nodes to connect"
-		if err.Error() != errMsg {
-			printError(t, "Bad edge connection (single)", errMsg, err.Error())
+		if !errors.Is(err, ErrTooFewEndpoints) {
+			printError(t, "Bad edge connection (single)", ErrTooFewEndpoints, err)
 		}
 	} else {
 		t.Error("Expected an error, invalid edge connections (none).")
 	}
 }
 
+func TestBadEdgeNode(t *testing.T) {
+	if _, err := LoadFileGraph("./data/e_unknownedgenode.json"); err != nil {
+		var nodeErr *NodeError
+		if !errors.Is(err, ErrNodeNotFound) || !errors.As(err, &nodeErr) {
+			printError(t, "Bad edge node", ErrNodeNotFound, err)
+		} else {
+			AssertT(t, "Bad edge node id", "9", nodeErr.ID)
+			AssertT(t, "Bad edge node message", "Unknown node id: 9", err.Error())
+		}
+	} else {
+		t.Error("Expected an error, unknown edge node.")
+	}
+}
+
+func TestBadEdgeNodeId(t *testing.T) {
+	if _, err := LoadFileGraph("./data/e_edgenodeid.json"); err != nil {
+		var edgeErr *EdgeError
+		if !errors.Is(err, ErrInvalidNodeID) || !errors.As(err, &edgeErr) {
+			printError(t, "Bad edge node id", ErrInvalidNodeID, err)
+		} else {
+			AssertT(t, "Bad edge node id edge", "e1", edgeErr.ID)
+		}
+	} else {
+		t.Error("Expected an error, a node id that is not a string.")
+	}
+}
+
+func TestAddEdgeUnknownNode(t *testing.T) {
+	graph, _ := NewGraph(GraphUndirected)
+	graph.AddNode("1")
+
+	if _, err := graph.AddEdge(NewAttributeCollection(), []string{"1", "2"}); err == nil {
+		t.Error("Expected an error, unknown node.")
+	}
+
+	AssertT(t, "Edges", 0, len(graph.Edges))
+	AssertT(t, "Node edges", 0, len(graph.Nodes["1"].Edges))
+}
+
+func TestAutoCreateNodes(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/ud_autocreate_4n_3e.json"); err != nil {
+		t.Error(err)
+	} else {
+		AssertT(t, "Auto create", true, graph.AutoCreateNodes)
+		AssertT(t, "Nodes", 4, len(graph.Nodes))
+		AssertT(t, "Edges", 3, len(graph.Edges))
+		AssertT(t, "Node 4 edges", 1, len(graph.Nodes["4"].Edges))
+		AssertAreConnected(t, graph, "3", "4", true)
+
+		color, _ := graph.Nodes["1"].Attributes.Get("color")
+		AssertT(t, "Listed node", "green", color)
+
+		if _, err = graph.AddEdge(NewAttributeCollection(), []string{"5"}); err == nil {
+			t.Error("Expected an error, single node edge.")
+		}
+		AssertT(t, "Rejected edge node", false, graph.Nodes["5"] != nil)
+
+		hyper, _ := NewGraph(GraphDirectedHyper)
+		hyper.AutoCreateNodes = true
+		if _, err = hyper.AddHyperedge(NewAttributeCollection(), []string{"a", "b"}, []string{"b", "c"}); err != nil {
+			t.Error(err)
+		}
+		AssertT(t, "Hyperedge nodes", 3, len(hyper.Nodes))
+	}
+}
+
 func TestGraphLoading(t *testing.T) {
 	if graph, err := LoadFileGraph("./data/ud_5n_7e.json"); err != nil {
 		t.Error(err)
@@ -0,0 +1,152 @@
+package graph
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestLoopLoading(t *testing.T) {
+	graph := LoadTestGraph(t, "./data/ud_loop_3n_4e.json")
+
+	AssertT(t, "Edges", 4, len(graph.Edges))
+	AssertT(t, "Loop 1-1", true, graph.Edges["1-1"].IsLoop())
+	AssertT(t, "Loop l3", true, graph.Edges["l3"].IsLoop())
+	AssertT(t, "Not a loop", false, graph.Edges["1-2"].IsLoop())
+
+	if _, err := graph.AddEdge(NewAttributeCollection(), []string{"2"}); err == nil {
+		t.Error("Expected an error, a loop repeats its node.")
+	}
+}
+
+func TestLoopDegree(t *testing.T) {
+	graph := LoadTestGraph(t, "./data/ud_loop_3n_4e.json")
+
+	AssertT(t, "Degree 1", 3, graph.Nodes["1"].Degree())
+	AssertT(t, "Degree 2", 2, graph.Nodes["2"].Degree())
+	AssertT(t, "Degree 3", 3, graph.Nodes["3"].Degree())
+
+	directed := buildGraph(t, GraphDirected, []string{"a", "b"}, [][]string{{"a", "a"}, {"a", "b"}})
+	AssertT(t, "Directed degree a", 3, directed.Nodes["a"].Degree())
+	AssertT(t, "Directed degree b", 1, directed.Nodes["b"].Degree())
+}
+
+func TestLoopAdjacency(t *testing.T) {
+	graph := LoadTestGraph(t, "./data/ud_loop_3n_4e.json")
+
+	AssertT(t, "Adjacent 1", 2, len(graph.Nodes["1"].Adj()))
+	AssertT(t, "Multiplicity 1", true, reflect.DeepEqual(map[string]int{"1": 1, "2": 1}, graph.Nodes["1"].Multiplicity()))
+	AssertT(t, "Multiplicity 2", true, reflect.DeepEqual(map[string]int{"1": 1, "3": 1}, graph.Nodes["2"].Multiplicity()))
+
+	if edges, err := graph.EdgesBetween("3", "3"); err != nil {
+		t.Fatal(err)
+	} else {
+		AssertT(t, "Loops of 3", 1, len(edges))
+		AssertT(t, "Loop id", "l3", edges[0].ID)
+	}
+
+	if edges, err := graph.EdgesBetween("2", "2"); err != nil {
+		t.Fatal(err)
+	} else {
+		AssertT(t, "Loops of 2", 0, len(edges))
+	}
+}
+
+func TestLoopAlgorithms(t *testing.T) {
+	graph := LoadTestGraph(t, "./data/ud_loop_3n_4e.json")
+
+	if err := graph.BFSd("1"); err != nil {
+		t.Fatal(err)
+	}
+	AssertNodeAttributeValue(t, graph, "1", "d", 0)
+	AssertNodeAttributeValue(t, graph, "3", "d", 2)
+
+	var cycles [][]string
+	err := graph.SimpleCycles(func(cycle []string) (bool, error) {
+		cycles = append(cycles, cycle)
+		return true, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertT(t, "Cycles", true, reflect.DeepEqual([][]string{{"1"}, {"3"}}, cycles))
+
+	modularity, err := graph.Modularity(map[string]int{"1": 0, "2": 0, "3": 1}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertT(t, "Modularity", true, math.Abs(modularity-0.21875) < 1e-9)
+
+	_, _, matrix, err := graph.IncidenceMatrix()
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertT(t, "Incidence of the loop", 2.0, matrix[0][0])
+
+	_, laplacian, err := graph.LaplacianMatrix("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertT(t, "Laplacian", true, reflect.DeepEqual([][]float64{{1, -1, 0}, {-1, 2, -1}, {0, -1, 1}}, laplacian))
+
+	_, adjacency, err := graph.AdjacencyMatrix("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertT(t, "Adjacency", true, reflect.DeepEqual([][]float64{{2, 1, 0}, {1, 0, 1}, {0, 1, 2}}, adjacency))
+
+	_, degrees, err := graph.DegreeMatrix("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, id := range graph.NodeOrder() {
+		AssertT(t, "Degree matrix "+id, float64(graph.Nodes[id].Degree()), degrees[i][i])
+	}
+
+	_, normalized, err := graph.NormalizedLaplacianMatrix("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	third, edge := 1.0/3, -1/math.Sqrt(6)
+	for i, row := range [][]float64{{third, edge, 0}, {edge, 1, edge}, {0, edge, third}} {
+		for j, expected := range row {
+			AssertT(t, "Normalized Laplacian", true, math.Abs(normalized[i][j]-expected) < 1e-9)
+		}
+	}
+
+	triangles, err := graph.Triangles()
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertT(t, "Triangles", 0, triangles["1"])
+}
+
+func TestLoopDegreeConvention(t *testing.T) {
+	graph := LoadTestGraph(t, "./data/ud_loop_3n_4e.json")
+
+	adj, err := newAdjacency(graph, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, matrix, err := graph.AdjacencyMatrix("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for u, id := range adj.ids {
+		sum := 0.0
+		for _, value := range matrix[u] {
+			sum += value
+		}
+		AssertT(t, "Snapshot degree "+id, graph.Nodes[id].Degree(), adj.degree(u))
+		AssertT(t, "Adjacency row "+id, float64(graph.Nodes[id].Degree()), sum)
+	}
+
+	looped := buildGraph(t, GraphUndirected, []string{"a", "b"}, [][]string{{"a", "a"}, {"a", "b"}})
+	centrality, _, err := looped.EigenvectorCentrality(&IterationOptions{MaxIterations: 1000, Tolerance: 1e-12})
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertT(t, "Eigenvector of [[2 1] [1 0]]", true, math.Abs(centrality["a"]/centrality["b"]-(1+math.Sqrt2)) < 1e-6)
+}
@@ -0,0 +1,145 @@
+package graph
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestMultigraphLoading(t *testing.T) {
+	graph := LoadTestGraph(t, "./data/ud_m_3n_5e.json")
+
+	AssertT(t, "Multigraph", true, graph.Multigraph)
+	AssertT(t, "Edges", 5, len(graph.Edges))
+
+	for _, id := range []string{"1-2", "1-2#2", "1-2#3", "2-3", "x"} {
+		if _, ok := graph.Edges[id]; !ok {
+			t.Error("Missing edge", id)
+		}
+	}
+
+	if _, err := graph.AddEdge(BuildAttributes(map[string]interface{}{"id": "x"}), []string{"1", "3"}); err == nil {
+		t.Error("Expected an error for an edge id already used")
+	} else {
+		AssertT(t, "Error", true, errors.Is(err, ErrDuplicateEdgeID))
+	}
+	AssertT(t, "Kept edge", 2, len(graph.Edges["x"].Nodes))
+}
+
+func TestMultiplicity(t *testing.T) {
+	graph := LoadTestGraph(t, "./data/ud_m_3n_5e.json")
+
+	AssertT(t, "Multiplicity 1", true, reflect.DeepEqual(map[string]int{"2": 3}, graph.Nodes["1"].Multiplicity()))
+	AssertT(t, "Multiplicity 2", true, reflect.DeepEqual(map[string]int{"1": 3, "3": 2}, graph.Nodes["2"].Multiplicity()))
+	AssertT(t, "Adjacent 2", 5, len(graph.Nodes["2"].Adj()))
+}
+
+func TestEdgesBetween(t *testing.T) {
+	graph := LoadTestGraph(t, "./data/ud_m_3n_5e.json")
+
+	for _, test := range []struct {
+		first, second string
+		expected      []string
+	}{
+		{"1", "2", []string{"1-2", "1-2#2", "1-2#3"}},
+		{"2", "1", []string{"1-2", "1-2#2", "1-2#3"}},
+		{"3", "2", []string{"2-3", "x"}},
+		{"1", "3", nil},
+		{"2", "2", nil},
+	} {
+		edges, err := graph.EdgesBetween(test.first, test.second)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var ids []string
+		for _, edge := range edges {
+			ids = append(ids, edge.ID)
+		}
+
+		if !reflect.DeepEqual(test.expected, ids) {
+			t.Errorf("Expected edges %v between %s and %s, got %v", test.expected, test.first, test.second, ids)
+		}
+	}
+
+	if _, err := graph.EdgesBetween("1", "9"); !errors.Is(err, ErrNodeNotFound) {
+		t.Error("Expected an error for an unknown node")
+	}
+
+	directed := buildGraph(t, GraphDirected, []string{"a", "b"}, nil)
+	directed.Multigraph = true
+	for _, ends := range [][]string{{"a", "b"}, {"b", "a"}, {"a", "b"}} {
+		if _, err := directed.AddEdge(NewAttributeCollection(), ends); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if edges, err := directed.EdgesBetween("a", "b"); err != nil {
+		t.Fatal(err)
+	} else {
+		AssertT(t, "Directed a->b", 2, len(edges))
+		AssertT(t, "Parallel id", "a-b#2", edges[1].ID)
+	}
+}
+
+func TestMultigraphAlgorithms(t *testing.T) {
+	graph := LoadTestGraph(t, "./data/ud_m_3n_5e.json")
+
+	_, matrix, err := graph.AdjacencyMatrix("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertT(t, "Adjacency 1-2", 3.0, matrix[0][1])
+	AssertT(t, "Adjacency 2-3", 2.0, matrix[1][2])
+
+	betweenness, err := graph.EdgeBetweenness("", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertT(t, "Betweenness 1-2#2", true, math.Abs(betweenness["1-2#2"]-2.0/3) < 1e-9)
+	AssertT(t, "Betweenness x", true, math.Abs(betweenness["x"]-1) < 1e-9)
+
+	basis, err := graph.CycleBasis()
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertT(t, "Cycle basis", 3, len(basis))
+
+	subgraph, err := graph.Subgraph([]string{"1", "2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertT(t, "Subgraph multigraph", true, subgraph.Multigraph)
+	AssertT(t, "Subgraph edges", 3, len(subgraph.Edges))
+}
+
+func TestMultigraphCycles(t *testing.T) {
+	graph := LoadTestGraph(t, "./data/ud_m_3n_5e.json")
+
+	var cycles [][]string
+	if err := graph.SimpleCycles(func(cycle []string) (bool, error) {
+		cycles = append(cycles, cycle)
+		return true, nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	AssertT(t, "Parallel cycles", true, reflect.DeepEqual([][]string{{"1", "2"}, {"1", "2"}, {"1", "2"}, {"2", "3"}}, cycles))
+
+	looped := buildGraph(t, GraphUndirected, []string{"a", "b"}, nil)
+	looped.Multigraph = true
+	for _, ends := range [][]string{{"a", "a"}, {"a", "b"}, {"a", "a"}, {"b", "b"}} {
+		if _, err := looped.AddEdge(NewAttributeCollection(), ends); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cycles = nil
+	if err := looped.SimpleCycles(func(cycle []string) (bool, error) {
+		cycles = append(cycles, cycle)
+		return true, nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	AssertT(t, "Loop cycles", true, reflect.DeepEqual([][]string{{"a"}, {"a"}, {"b"}}, cycles))
+}
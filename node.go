@@ -1,7 +1,5 @@
 package graph
 
-import "errors"
-
 // Node is the vertex of the graph.
 type Node struct {
 
@@ -25,20 +23,22 @@ func NewNode(id string) (node *Node, err error) {
 			Edges:      make(map[string]*Edge),
 		}
 	} else {
-		err = errors.New("Node must have a valid id")
+		err = &NodeError{Err: ErrInvalidNodeID}
 	}
 
 	return
 }
 
-// Adj will return the adjacent nodes.
+// Adj will return the adjacent nodes. A node is listed once for every edge
+// joining them, see Multiplicity for the counts. A node with loops is adjacent
+// to itself, listed once for every loop.
 func (node *Node) Adj() (adjacent []*Node) {
 
 	adjacent = []*Node{}
 
 	for _, edge := range node.Edges {
 		for _, n := range edge.Nodes {
-			if n != node {
+			if n != node || edge.IsLoop() {
 				adjacent = append(adjacent, n)
 			}
 		}
@@ -46,3 +46,32 @@ func (node *Node) Adj() (adjacent []*Node) {
 
 	return
 }
+
+// Multiplicity will count the edges joining the node to every adjacent node,
+// keyed by node id. The loops are counted under the id of the node itself.
+func (node *Node) Multiplicity() (counts map[string]int) {
+
+	counts = map[string]int{}
+
+	for _, n := range node.Adj() {
+		counts[n.ID]++
+	}
+
+	return
+}
+
+// Degree will count the edge ends at the node: every edge counts once, except
+// loops that count twice. For directed graphs this is the sum of the in and the
+// out-degree.
+func (node *Node) Degree() (degree int) {
+
+	for _, edge := range node.Edges {
+		for _, n := range edge.Order {
+			if n == node {
+				degree++
+			}
+		}
+	}
+
+	return
+}
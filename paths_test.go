@@ -0,0 +1,82 @@
+package graph
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestAllSimplePaths(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/d_yen_6n_9e.json"); err != nil {
+		t.Error(err)
+	} else {
+		var paths []string
+		if err = graph.AllSimplePaths("C", "H", 0, func(path *Path) (cont bool, err error) {
+			AssertT(t, "Path cost", float64(len(path.Edges)), path.Cost)
+			paths = append(paths, strings.Join(path.Nodes, ""))
+			return true, nil
+		}); err != nil {
+			t.Error(err)
+		}
+
+		sort.Strings(paths)
+		AssertWithCheckFunc(t, "Paths", []string{"CDFGH", "CDFH", "CEDFGH", "CEDFH", "CEFGH", "CEFH", "CEGH"}, paths, reflect.DeepEqual)
+
+		count := 0
+		graph.AllSimplePaths("C", "H", 3, func(path *Path) (cont bool, err error) {
+			count++
+			return true, nil
+		})
+		AssertT(t, "Short paths", 3, count)
+
+		count = 0
+		graph.AllSimplePaths("C", "H", 0, func(path *Path) (cont bool, err error) {
+			count++
+			return count < 2, nil
+		})
+		AssertT(t, "Early stop", 2, count)
+	}
+}
+
+func TestSimpleCyclesDirected(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/d_cfg_6n_7e.json"); err != nil {
+		t.Error(err)
+	} else {
+		graph.AddEdge(NewAttributeCollection(), []string{"exit", "entry"})
+
+		var cycles []string
+		if err = graph.SimpleCycles(func(cycle []string) (cont bool, err error) {
+			cycles = append(cycles, strings.Join(cycle, ","))
+			return true, nil
+		}); err != nil {
+			t.Error(err)
+		}
+
+		sort.Strings(cycles)
+		AssertWithCheckFunc(t, "Cycles", []string{
+			"else,join,loop",
+			"entry,loop,exit",
+			"join,loop,then",
+		}, cycles, reflect.DeepEqual)
+	}
+}
+
+func TestSimpleCyclesUndirected(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/ud_5n_7e.json"); err != nil {
+		t.Error(err)
+	} else {
+		count := 0
+		if err = graph.SimpleCycles(func(cycle []string) (cont bool, err error) {
+			for _, id := range cycle[1:] {
+				AssertT(t, "Cycle start", true, cycle[0] < id)
+			}
+			count++
+			return true, nil
+		}); err != nil {
+			t.Error(err)
+		}
+
+		AssertT(t, "Undirected cycles", 6, count)
+	}
+}
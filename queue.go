@@ -0,0 +1,40 @@
+package graph
+
+import "container/heap"
+
+// queueItem is a node waiting in the priority queue.
+type queueItem struct {
+
+	// node index the item is for.
+	node int
+
+	// priority of the item, lowest first.
+	priority float64
+}
+
+// priorityQueue is a min-heap of nodes used by the shortest path searches.
+// Stale entries are expected to be skipped by the caller.
+type priorityQueue []queueItem
+
+func (pq priorityQueue) Len() int            { return len(pq) }
+func (pq priorityQueue) Less(i, j int) bool  { return pq[i].priority < pq[j].priority }
+func (pq priorityQueue) Swap(i, j int)       { pq[i], pq[j] = pq[j], pq[i] }
+func (pq *priorityQueue) Push(x interface{}) { *pq = append(*pq, x.(queueItem)) }
+
+func (pq *priorityQueue) Pop() interface{} {
+	old := *pq
+	item := old[len(old)-1]
+	*pq = old[:len(old)-1]
+	return item
+}
+
+// push will add the node with the priority.
+func (pq *priorityQueue) push(node int, priority float64) {
+	heap.Push(pq, queueItem{node: node, priority: priority})
+}
+
+// pop will remove the node with the lowest priority.
+func (pq *priorityQueue) pop() (node int, priority float64) {
+	item := heap.Pop(pq).(queueItem)
+	return item.node, item.priority
+}
@@ -0,0 +1,113 @@
+package graph
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func edgeIdsOf(edges []*Edge) (ids []string) {
+	for _, edge := range edges {
+		ids = append(ids, edge.ID)
+	}
+	return
+}
+
+func TestRemoveEdge(t *testing.T) {
+	graph, err := LoadFileGraph("./data/ud_5n_7e.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	edge, err := graph.RemoveEdge("f")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	AssertT(t, "Removed", "f", edge.ID)
+	AssertT(t, "Kept nodes", 2, len(edge.Nodes))
+	AssertT(t, "Edges", 6, len(graph.Edges))
+	AssertT(t, "Node 3 edges", 1, len(graph.Nodes["3"].Edges))
+	AssertAreConnected(t, graph, "3", "4", false)
+
+	if _, err = graph.RemoveEdge("f"); !errors.Is(err, ErrEdgeNotFound) {
+		t.Error("Expected an error, the edge is gone.")
+	}
+}
+
+func TestRemoveNode(t *testing.T) {
+	graph, err := LoadFileGraph("./data/ud_5n_7e.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	node, edges, err := graph.RemoveNode("2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	AssertT(t, "Removed", "2", node.ID)
+	AssertT(t, "Removed edges", true, reflect.DeepEqual([]string{"a", "c", "d", "e"}, edgeIdsOf(edges)))
+	AssertT(t, "Nodes", 4, len(graph.Nodes))
+	AssertT(t, "Edges", 3, len(graph.Edges))
+
+	for id, node := range graph.Nodes {
+		for _, edge := range node.Edges {
+			if _, ok := edge.Nodes["2"]; ok {
+				t.Errorf("Node %s still holds edge %s", id, edge.ID)
+			}
+		}
+	}
+
+	if _, _, err = graph.RemoveNode("2"); !errors.Is(err, ErrNodeNotFound) {
+		t.Error("Expected an error, the node is gone.")
+	}
+
+	hyper, err := LoadFileGraph("./data/h_7n_4e.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, edges, err = hyper.RemoveNode("3"); err != nil {
+		t.Fatal(err)
+	}
+	AssertT(t, "Removed hyperedges", true, reflect.DeepEqual([]string{"a", "b", "c"}, edgeIdsOf(edges)))
+	AssertT(t, "Node 4 edges", 0, len(hyper.Nodes["4"].Edges))
+}
+
+func TestRemoveByPredicate(t *testing.T) {
+	graph, err := LoadFileGraph("./data/ud_5n_7e.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	edges := graph.RemoveEdges(func(edge *Edge) bool {
+		style, _ := edge.Attributes.Get("style")
+		return style == "dashed"
+	})
+	AssertT(t, "Removed dashed", true, reflect.DeepEqual([]string{"a"}, edgeIdsOf(edges)))
+
+	nodes, edges := graph.RemoveNodes(func(node *Node) bool {
+		color, _ := node.Attributes.Get("color")
+		return color == "green" || node.ID == "4"
+	})
+
+	var ids []string
+	for _, node := range nodes {
+		ids = append(ids, node.ID)
+	}
+
+	AssertT(t, "Removed nodes", true, reflect.DeepEqual([]string{"1", "4"}, ids))
+	AssertT(t, "Removed edges", true, reflect.DeepEqual([]string{"b", "d", "f", "g"}, edgeIdsOf(edges)))
+	AssertT(t, "Edges", 2, len(graph.Edges))
+	AssertT(t, "Node 5 edges", 1, len(graph.Nodes["5"].Edges))
+
+	nodes, edges = graph.RemoveNodes(func(node *Node) bool { return false })
+	AssertT(t, "Nothing removed", 0, len(nodes)+len(edges))
+
+	nodes, edges = graph.RemoveNodes(nil)
+	AssertT(t, "Nil node predicate", 0, len(nodes)+len(edges))
+	AssertT(t, "Nil edge predicate", 0, len(graph.RemoveEdges(nil)))
+	AssertT(t, "Nodes kept", 3, len(graph.Nodes))
+	AssertT(t, "Edges kept", 2, len(graph.Edges))
+}
@@ -0,0 +1,135 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"testing"
+)
+
+func buildPath(t *testing.T, ids ...string) (graph *Graph) {
+	graph, _ = NewGraph(GraphUndirected)
+	for i, id := range ids {
+		if _, err := graph.AddNode(id); err != nil {
+			t.Fatal(err)
+		}
+		if i > 0 {
+			if _, err := graph.AddEdge(NewAttributeCollection(), []string{ids[i-1], id}); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	return
+}
+
+func TestLaplacianMatrix(t *testing.T) {
+	graph := buildPath(t, "c", "a", "b")
+
+	order, matrix, err := graph.LaplacianMatrix("")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	AssertWithCheckFunc(t, "Order", []string{"a", "b", "c"}, order, reflect.DeepEqual)
+	AssertWithCheckFunc(t, "Laplacian", [][]float64{
+		{2, -1, -1},
+		{-1, 1, 0},
+		{-1, 0, 1},
+	}, matrix, reflect.DeepEqual)
+
+	if _, matrix, err = graph.NormalizedLaplacianMatrix(""); err == nil {
+		AssertT(t, "Normalized diagonal", 1.0, matrix[0][0])
+		AssertT(t, "Normalized off diagonal", true, math.Abs(matrix[0][2]+math.Sqrt(0.5)) < 1e-12)
+	} else {
+		t.Error(err)
+	}
+}
+
+func TestAlgebraicConnectivity(t *testing.T) {
+	graph := buildPath(t, "1", "2", "3", "4")
+
+	if connectivity, err := graph.AlgebraicConnectivity("", false); err == nil {
+		expected := 2 - 2*math.Cos(math.Pi/4)
+		AssertT(t, "Path connectivity", true, math.Abs(connectivity-expected) < 1e-6)
+	} else {
+		t.Error(err)
+	}
+
+	if vector, err := graph.FiedlerVector("", false); err == nil {
+		AssertT(t, "Fiedler sign", true, vector["1"] > 0)
+		AssertT(t, "Fiedler symmetry", true, math.Abs(vector["1"]+vector["4"]) < 1e-6)
+	} else {
+		t.Error(err)
+	}
+}
+
+func TestSpectralBisection(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/ud_6n_7e.json"); err != nil {
+		t.Error(err)
+	} else {
+		for _, normalized := range []bool{false, true} {
+			first, second, err := graph.SpectralBisection("", normalized)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+
+			AssertWithCheckFunc(t, "First half", []string{"a", "b", "c"}, first, reflect.DeepEqual)
+			AssertWithCheckFunc(t, "Second half", []string{"d", "e", "f"}, second, reflect.DeepEqual)
+		}
+	}
+}
+
+func TestSmallestEigenpairs(t *testing.T) {
+	values, vectors, err := SmallestEigenpairs([][]float64{
+		{2, 1, 0},
+		{1, 2, 0},
+		{0, 0, 5},
+	}, 3)
+
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	for i, expected := range []float64{1, 3, 5} {
+		AssertT(t, "Eigenvalue", true, math.Abs(values[i]-expected) < 1e-6)
+	}
+	AssertT(t, "Eigenvector", true, math.Abs(vectors[0][0]-math.Sqrt(0.5)) < 1e-6)
+}
+
+func TestAlgebraicConnectivityOfLongPath(t *testing.T) {
+	var ids []string
+	for i := 0; i < 300; i++ {
+		ids = append(ids, fmt.Sprintf("%03d", i))
+	}
+	graph := buildPath(t, ids...)
+
+	connectivity, err := graph.AlgebraicConnectivity("", false)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	AssertT(t, "Connectivity", true, math.Abs(connectivity-2*(1-math.Cos(math.Pi/300))) < 1e-9)
+}
+
+func TestSmallestEigenpairsRepeated(t *testing.T) {
+	// the Laplacian of the complete graph on four nodes.
+	values, _, err := SmallestEigenpairs([][]float64{
+		{3, -1, -1, -1},
+		{-1, 3, -1, -1},
+		{-1, -1, 3, -1},
+		{-1, -1, -1, 3},
+	}, 4)
+
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	for i, expected := range []float64{0, 4, 4, 4} {
+		AssertT(t, "Eigenvalue", true, math.Abs(values[i]-expected) < 1e-6)
+	}
+}
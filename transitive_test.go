@@ -0,0 +1,101 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestReachabilityIndex(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/d_4n_5e.json"); err != nil {
+		t.Error(err)
+	} else {
+		reach, err := graph.ReachabilityIndex()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		AssertT(t, "a reaches b", true, reach.Reaches("a", "b"))
+		AssertT(t, "b reaches a", true, reach.Reaches("b", "a"))
+		AssertT(t, "a reaches itself", true, reach.Reaches("a", "a"))
+		AssertT(t, "d reaches b", true, reach.Reaches("d", "b"))
+		AssertT(t, "a reaches d", false, reach.Reaches("a", "d"))
+		AssertT(t, "d reaches itself", false, reach.Reaches("d", "d"))
+	}
+}
+
+func TestTransitiveClosure(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/d_dag_6n_8e.json"); err != nil {
+		t.Error(err)
+	} else {
+		closure, err := graph.TransitiveClosure()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		AssertT(t, "Closure nodes", 6, len(closure.Nodes))
+		AssertT(t, "Closure edges", 10, len(closure.Edges))
+		for _, id := range []string{"app-http", "app-net", "http-os"} {
+			if _, ok := closure.Edges[id]; !ok {
+				t.Error("Expected closure edge " + id)
+			}
+		}
+	}
+}
+
+func TestTransitiveClosureIds(t *testing.T) {
+	graph := buildGraph(t, GraphDirected, []string{"a", "b", "c"}, [][]string{{"b", "c"}})
+	if _, err := graph.AddEdge(BuildAttributes(map[string]interface{}{"id": "a-c"}), []string{"a", "b"}); err != nil {
+		t.Fatal(err)
+	}
+
+	closure, err := graph.TransitiveClosure()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	AssertT(t, "Closure edges", 3, len(closure.Edges))
+	AssertT(t, "Explicit id kept", true, reflect.DeepEqual([]string{"a", "b"}, idsOf(closure.Edges["a-c"].Order)))
+	AssertT(t, "Numbered id", true, reflect.DeepEqual([]string{"a", "c"}, idsOf(closure.Edges["a-c#2"].Order)))
+	AssertT(t, "Node a edges", 2, len(closure.Nodes["a"].Edges))
+}
+
+func TestTransitiveReduction(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/d_dag_6n_8e.json"); err != nil {
+		t.Error(err)
+	} else {
+		reduction, err := graph.TransitiveReduction()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		AssertT(t, "Reduction nodes", 6, len(reduction.Nodes))
+		AssertT(t, "Reduction edges", 6, len(reduction.Edges))
+		for _, id := range []string{"app-log", "app-os"} {
+			if _, ok := reduction.Edges[id]; ok {
+				t.Error("Expected redundant edge " + id + " to be removed")
+			}
+		}
+
+		if edge, ok := reduction.Edges["app-http"]; ok {
+			AssertAttributes(t, "Kept attributes", graph.Edges["app-http"].Attributes, edge.Attributes)
+		} else {
+			t.Error("Expected edge app-http to be kept")
+		}
+	}
+}
+
+func TestTransitiveReductionCycle(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/d_4n_5e.json"); err != nil {
+		t.Error(err)
+	} else if _, err = graph.TransitiveReduction(); err != nil {
+		errMsg := "Graph must be acyclic"
+		if err.Error() != errMsg {
+			printError(t, "Cyclic reduction", errMsg, err.Error())
+		}
+	} else {
+		t.Error("Expected an error, cyclic graph.")
+	}
+}
@@ -0,0 +1,60 @@
+package graph
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestTriangles(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/ud_5n_7e.json"); err != nil {
+		t.Error(err)
+	} else {
+		triangles, err := graph.Triangles()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		expected := map[string]int{"1": 1, "2": 3, "3": 1, "4": 2, "5": 2}
+		for id, count := range expected {
+			AssertT(t, "Triangles["+id+"]", count, triangles[id])
+		}
+	}
+}
+
+func TestClustering(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/ud_5n_7e.json"); err != nil {
+		t.Error(err)
+	} else {
+		clustering, err := graph.Clustering()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		AssertT(t, "Clustering[1]", 1.0, clustering["1"])
+		AssertT(t, "Clustering[2]", 0.5, clustering["2"])
+		AssertT(t, "Clustering[4]", 2.0/3.0, clustering["4"])
+
+		if average, err := graph.AverageClustering(); err == nil {
+			AssertT(t, "Average clustering", true, math.Abs(average-(1+0.5+1+2.0/3.0+2.0/3.0)/5) < 1e-12)
+		} else {
+			t.Error(err)
+		}
+
+		if transitivity, err := graph.Transitivity(); err == nil {
+			AssertT(t, "Transitivity", 9.0/14.0, transitivity)
+		} else {
+			t.Error(err)
+		}
+	}
+}
+
+func TestTrianglesDirected(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/d_4n_5e.json"); err != nil {
+		t.Error(err)
+	} else if _, err = graph.Triangles(); !errors.Is(err, ErrNotUndirected) {
+		t.Error("Expected an error, directed graph.")
+	}
+}
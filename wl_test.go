@@ -0,0 +1,110 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestWLHash(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/ud_5n_7e.json"); err != nil {
+		t.Error(err)
+	} else {
+		relabeled := buildGraph(t, GraphUndirected, []string{"a", "b", "c", "d", "e"}, [][]string{
+			{"c", "a"}, {"c", "e"}, {"c", "b"}, {"c", "d"}, {"a", "b"}, {"b", "d"}, {"d", "e"},
+		})
+		different := buildGraph(t, GraphUndirected, []string{"a", "b", "c", "d", "e"}, [][]string{
+			{"a", "b"}, {"a", "c"}, {"a", "d"}, {"b", "c"}, {"b", "d"}, {"c", "d"}, {"d", "e"},
+		})
+
+		hash, err := graph.WLHash(nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		AssertT(t, "Hash length", 32, len(hash))
+
+		if other, err := relabeled.WLHash(nil); err == nil {
+			AssertT(t, "Isomorphic hash", hash, other)
+		} else {
+			t.Error(err)
+		}
+
+		if other, err := different.WLHash(nil); err == nil {
+			AssertT(t, "Different hash", true, hash != other)
+		} else {
+			t.Error(err)
+		}
+
+		options := &WLOptions{NodeAttr: "color"}
+		colored, _ := graph.WLHash(options)
+		relabeled.Nodes["b"].Attributes.Set("color", "green")
+		if other, err := relabeled.WLHash(options); err == nil {
+			AssertT(t, "Colored hash", true, colored != other)
+		} else {
+			t.Error(err)
+		}
+	}
+}
+
+func TestWLSubtreeHashes(t *testing.T) {
+	if graph, err := LoadFileGraph("./data/ud_5n_7e.json"); err != nil {
+		t.Error(err)
+	} else {
+		hashes, err := graph.WLSubtreeHashes(&WLOptions{Iterations: 2})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		AssertT(t, "Iterations", 2, len(hashes["1"]))
+		AssertT(t, "Symmetric nodes", hashes["1"][1], hashes["3"][1])
+		AssertT(t, "Distinct nodes", true, hashes["1"][1] != hashes["2"][1])
+
+		graph.Edges["a"].Attributes.Set("style", "dashed")
+		if edged, err := graph.WLSubtreeHashes(&WLOptions{EdgeAttr: "style", Iterations: 2}); err == nil {
+			AssertT(t, "Edge labels", true, edged["1"][1] != edged["3"][1])
+		} else {
+			t.Error(err)
+		}
+	}
+}
+
+func TestWLLabelsUnambiguous(t *testing.T) {
+	subtree := func(graph *Graph, options *WLOptions) string {
+		hashes, err := graph.WLSubtreeHashes(options)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return hashes["a"][0]
+	}
+
+	// the edge label and the neighbour label must not run together.
+	first := buildGraph(t, GraphDirected, []string{"a", "b"}, [][]string{{"a", "b"}})
+	first.Edges["a-b"].Attributes.Set("style", "x")
+	first.Nodes["b"].Attributes.Set("color", "yz")
+	second := buildGraph(t, GraphDirected, []string{"a", "b"}, [][]string{{"a", "b"}})
+	second.Edges["a-b"].Attributes.Set("style", "xy")
+	second.Nodes["b"].Attributes.Set("color", "z")
+	options := &WLOptions{NodeAttr: "color", EdgeAttr: "style", Iterations: 1}
+	AssertT(t, "Edge and node labels", true, subtree(first, options) != subtree(second, options))
+
+	// the labels of the neighbours must not run together.
+	first = buildGraph(t, GraphDirected, []string{"a", "b", "c"}, [][]string{{"a", "b"}, {"a", "c"}})
+	first.Nodes["b"].Attributes.Set("color", "x")
+	first.Nodes["c"].Attributes.Set("color", "y")
+	second = buildGraph(t, GraphDirected, []string{"a", "b", "c"}, [][]string{{"a", "b"}})
+	second.Nodes["b"].Attributes.Set("color", "x,y")
+	options = &WLOptions{NodeAttr: "color", Iterations: 1}
+	AssertT(t, "Neighbour labels", true, subtree(first, options) != subtree(second, options))
+
+	// values of different types, and missing values, must label differently.
+	first = buildGraph(t, GraphUndirected, []string{"a", "b"}, [][]string{{"a", "b"}})
+	second = buildGraph(t, GraphUndirected, []string{"a", "b"}, [][]string{{"a", "b"}})
+	first.Nodes["a"].Attributes.Set("color", 1)
+	second.Nodes["a"].Attributes.Set("color", "1")
+	AssertT(t, "Typed labels", true, subtree(first, options) != subtree(second, options))
+
+	first.Nodes["a"].Attributes.Set("color", "")
+	delete(second.Nodes["a"].Attributes, "color")
+	AssertT(t, "Missing labels", true, subtree(first, options) != subtree(second, options))
+}